@@ -0,0 +1,48 @@
+use crate::conversions::*;
+use crate::set_last_error;
+use polars::prelude::*;
+use std::ptr;
+
+// The global string cache gives categoricals created while it is enabled a
+// shared encoding, so they can be compared, joined and concatenated.
+#[no_mangle]
+pub extern "C" fn string_cache_enable() {
+    enable_string_cache();
+}
+
+#[no_mangle]
+pub extern "C" fn string_cache_disable() {
+    disable_string_cache();
+}
+
+#[no_mangle]
+pub extern "C" fn string_cache_enabled() -> u8 {
+    using_string_cache() as u8
+}
+
+// Returns the categories of a Categorical or Enum Series, or of a List of
+// them, as a String Series.
+#[no_mangle]
+pub extern "C" fn series_get_categories(series: *const CSeries) -> *mut CSeries {
+    unsafe {
+        let result = c_series_to_series(series).and_then(|s| {
+            let s = match s.dtype() {
+                DataType::List(_) => s.explode().map_err(|e| e.to_string())?,
+                _ => s,
+            };
+            let name = s.name().clone();
+            s.into_frame()
+                .lazy()
+                .select([col(name).cat().get_categories()])
+                .collect()
+                .map_err(|e| e.to_string())
+        });
+        match result {
+            Ok(df) => series_to_c_series(df.get_columns()[0].as_materialized_series().clone()),
+            Err(e) => {
+                set_last_error(&format!("Get categories error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
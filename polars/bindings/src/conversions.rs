@@ -1,7 +1,16 @@
 use polars::prelude::*;
 use std::cell::RefCell;
-use std::ffi::c_void;
+use polars::prelude::arrow::array::Utf8ViewArray;
+use std::ffi::{c_char, c_void, CStr, CString};
+use std::ptr;
 use std::rc::Rc;
+use std::sync::atomic::{AtomicUsize, Ordering};
+
+// Number of handles currently owned by Go, decremented by the free_* functions.
+pub static LIVE_DATAFRAMES: AtomicUsize = AtomicUsize::new(0);
+pub static LIVE_EXPRS: AtomicUsize = AtomicUsize::new(0);
+pub static LIVE_GROUPBYS: AtomicUsize = AtomicUsize::new(0);
+pub static LIVE_SERIES: AtomicUsize = AtomicUsize::new(0);
 
 #[repr(C)]
 pub struct CDataFrame {
@@ -18,11 +27,17 @@ pub struct CGroupBy {
     pub inner: *mut c_void,
 }
 
+#[repr(C)]
+pub struct CSeries {
+    pub inner: *mut c_void,
+}
+
 pub fn polars_df_to_c_df(df: DataFrame) -> *mut CDataFrame {
     let rc_df = Rc::new(RefCell::new(df));
     let boxed_df = Box::new(rc_df);
     let inner = Box::into_raw(boxed_df) as *mut c_void;
     let c_df = CDataFrame { inner };
+    LIVE_DATAFRAMES.fetch_add(1, Ordering::Relaxed);
     Box::into_raw(Box::new(c_df))
 }
 
@@ -48,33 +63,385 @@ pub fn expr_to_c_expr(expr: Expr) -> *mut CExpr {
     let boxed_expr = Box::new(expr);
     let ptr = Box::into_raw(boxed_expr) as *mut c_void;
     let c_expr = CExpr { inner: ptr };
+    LIVE_EXPRS.fetch_add(1, Ordering::Relaxed);
     Box::into_raw(Box::new(c_expr))
 }
 
+// Expressions are cloned out of their handle so the caller keeps ownership and
+// can reuse the same CExpr; it is only released by free_expr.
 pub unsafe fn c_expr_to_expr(c_expr: *mut CExpr) -> Result<Expr, String> {
     if c_expr.is_null() || (*c_expr).inner.is_null() {
         return Err("CExpr or inner pointer is null".to_string());
     }
-    let c_expr_struct = Box::from_raw(c_expr);
-    let expr_ptr = c_expr_struct.inner as *mut Expr;
-    let expr = *Box::from_raw(expr_ptr);
-    Ok(expr)
+    let expr_ptr = (*c_expr).inner as *const Expr;
+    Ok((*expr_ptr).clone())
 }
 
 pub fn groupby_to_c_groupby(gb: LazyGroupBy) -> *mut CGroupBy {
     let boxed_gb = Box::new(gb);
     let inner = Box::into_raw(boxed_gb) as *mut c_void;
     let c_gb = CGroupBy { inner };
+    LIVE_GROUPBYS.fetch_add(1, Ordering::Relaxed);
     Box::into_raw(Box::new(c_gb))
 }
 
-#[allow(dead_code)]
-pub unsafe fn c_groupby_to_groupby(c_gb: *mut CGroupBy) -> Result<GroupBy<'static>, String> {
+// Like expressions, group-bys are cloned so the same CGroupBy can be
+// aggregated several times; it is only released by free_groupby.
+pub unsafe fn c_groupby_to_lazy_groupby(c_gb: *mut CGroupBy) -> Result<LazyGroupBy, String> {
     if c_gb.is_null() || (*c_gb).inner.is_null() {
-        return Err("CGroupBy or inner pointer is null".to_string());
+        return Err("GroupBy pointer is null".to_string());
     }
-    let c_gb_struct = Box::from_raw(c_gb);
-    let gb_ptr = c_gb_struct.inner as *mut GroupBy;
-    let gb = *Box::from_raw(gb_ptr);
-    Ok(gb)
+    let gb_ptr = (*c_gb).inner as *const LazyGroupBy;
+    Ok((*gb_ptr).clone())
+}
+
+pub fn series_to_c_series(series: Series) -> *mut CSeries {
+    let boxed_series = Box::new(series);
+    let inner = Box::into_raw(boxed_series) as *mut c_void;
+    let c_series = CSeries { inner };
+    LIVE_SERIES.fetch_add(1, Ordering::Relaxed);
+    Box::into_raw(Box::new(c_series))
+}
+
+// Series are reference counted internally, so cloning one out of its handle is cheap.
+pub unsafe fn c_series_to_series(c_series: *const CSeries) -> Result<Series, String> {
+    if c_series.is_null() || (*c_series).inner.is_null() {
+        return Err("CSeries or inner pointer is null".to_string());
+    }
+    let series_ptr = (*c_series).inner as *const Series;
+    Ok((*series_ptr).clone())
+}
+
+// Value type enum for scalars handed to Go
+#[repr(C)]
+#[derive(Clone, Copy)]
+pub enum CValueType {
+    Null = 0,
+    Bool = 1,
+    Int64 = 2,
+    UInt64 = 3,
+    Float64 = 4,
+    String = 5,
+    Date = 6,
+    Datetime = 7,
+    List = 8,
+    Struct = 9,
+    Decimal = 10,
+}
+
+// A single value. Strings are owned by the receiver, which frees them with
+// free_c_string. Dates are days and datetimes microseconds since the Unix epoch.
+// Lists hold list_len values, released with free_value_list once converted.
+// Structs hold their fields the same way, each with its field name in name.
+// Decimals are an i128 mantissa split into int_value (high 64 bits) and
+// uint_value (low 64 bits), divided by 10^scale.
+#[repr(C)]
+pub struct CValue {
+    pub value_type: CValueType,
+    pub int_value: i64,
+    pub uint_value: u64,
+    pub float_value: f64,
+    pub string_value: *mut c_char,
+    pub list_values: *mut CValue,
+    pub list_len: usize,
+    pub name: *mut c_char,
+    pub scale: usize,
+}
+
+impl CValue {
+    fn new(value_type: CValueType) -> Self {
+        CValue {
+            value_type,
+            int_value: 0,
+            uint_value: 0,
+            float_value: 0.0,
+            string_value: ptr::null_mut(),
+            list_values: ptr::null_mut(),
+            list_len: 0,
+            name: ptr::null_mut(),
+            scale: 0,
+        }
+    }
+
+    fn string(s: &str) -> Self {
+        let mut value = CValue::new(CValueType::String);
+        value.string_value = CString::new(s).unwrap_or_default().into_raw();
+        value
+    }
+}
+
+pub fn any_value_to_c_value(value: &AnyValue) -> CValue {
+    let mut c_value;
+    match value {
+        AnyValue::Null => return CValue::new(CValueType::Null),
+        AnyValue::Boolean(v) => {
+            c_value = CValue::new(CValueType::Bool);
+            c_value.int_value = *v as i64;
+        }
+        AnyValue::Int8(v) => {
+            c_value = CValue::new(CValueType::Int64);
+            c_value.int_value = *v as i64;
+        }
+        AnyValue::Int16(v) => {
+            c_value = CValue::new(CValueType::Int64);
+            c_value.int_value = *v as i64;
+        }
+        AnyValue::Int32(v) => {
+            c_value = CValue::new(CValueType::Int64);
+            c_value.int_value = *v as i64;
+        }
+        AnyValue::Int64(v) => {
+            c_value = CValue::new(CValueType::Int64);
+            c_value.int_value = *v;
+        }
+        AnyValue::UInt8(v) => {
+            c_value = CValue::new(CValueType::UInt64);
+            c_value.uint_value = *v as u64;
+        }
+        AnyValue::UInt16(v) => {
+            c_value = CValue::new(CValueType::UInt64);
+            c_value.uint_value = *v as u64;
+        }
+        AnyValue::UInt32(v) => {
+            c_value = CValue::new(CValueType::UInt64);
+            c_value.uint_value = *v as u64;
+        }
+        AnyValue::UInt64(v) => {
+            c_value = CValue::new(CValueType::UInt64);
+            c_value.uint_value = *v;
+        }
+        AnyValue::Float32(v) => {
+            c_value = CValue::new(CValueType::Float64);
+            c_value.float_value = *v as f64;
+        }
+        AnyValue::Float64(v) => {
+            c_value = CValue::new(CValueType::Float64);
+            c_value.float_value = *v;
+        }
+        AnyValue::String(v) => return CValue::string(v),
+        AnyValue::StringOwned(v) => return CValue::string(v.as_str()),
+        AnyValue::Date(v) => {
+            c_value = CValue::new(CValueType::Date);
+            c_value.int_value = *v as i64;
+        }
+        AnyValue::Datetime(v, unit, _) | AnyValue::DatetimeOwned(v, unit, _) => {
+            c_value = CValue::new(CValueType::Datetime);
+            c_value.int_value = match unit {
+                TimeUnit::Nanoseconds => *v / 1_000,
+                TimeUnit::Microseconds => *v,
+                TimeUnit::Milliseconds => *v * 1_000,
+            };
+        }
+        AnyValue::List(s) => {
+            let values: Box<[CValue]> = (0..s.len())
+                .map(|i| any_value_to_c_value(&s.get(i).unwrap_or(AnyValue::Null)))
+                .collect();
+            c_value = CValue::new(CValueType::List);
+            c_value.list_len = values.len();
+            c_value.list_values = Box::into_raw(values) as *mut CValue;
+        }
+        AnyValue::Decimal(v, scale) => {
+            c_value = CValue::new(CValueType::Decimal);
+            c_value.int_value = (*v >> 64) as i64;
+            c_value.uint_value = *v as u64;
+            c_value.scale = *scale;
+        }
+        AnyValue::Categorical(..)
+        | AnyValue::CategoricalOwned(..)
+        | AnyValue::Enum(..)
+        | AnyValue::EnumOwned(..) => return CValue::string(value.get_str().unwrap_or_default()),
+        AnyValue::Struct(_, _, fields) => return struct_to_c_value(value, fields),
+        AnyValue::StructOwned(payload) => return struct_to_c_value(value, &payload.1),
+        other => return CValue::string(&other.to_string()),
+    }
+    c_value
+}
+
+fn struct_to_c_value(value: &AnyValue, fields: &[Field]) -> CValue {
+    let values: Box<[CValue]> = value
+        ._iter_struct_av()
+        .zip(fields)
+        .map(|(field_value, field)| {
+            let mut c_field = any_value_to_c_value(&field_value);
+            c_field.name = CString::new(field.name().as_str())
+                .unwrap_or_default()
+                .into_raw();
+            c_field
+        })
+        .collect();
+    let mut c_value = CValue::new(CValueType::Struct);
+    c_value.list_len = values.len();
+    c_value.list_values = Box::into_raw(values) as *mut CValue;
+    c_value
+}
+
+// Releases the strings and lists owned by a value that is never handed to the
+// receiver, such as the values already written when a batch fails.
+pub(crate) unsafe fn release_c_value(value: &CValue) {
+    if !value.string_value.is_null() {
+        drop(CString::from_raw(value.string_value));
+    }
+    if !value.name.is_null() {
+        drop(CString::from_raw(value.name));
+    }
+    if !value.list_values.is_null() {
+        let values = Box::from_raw(ptr::slice_from_raw_parts_mut(value.list_values, value.list_len));
+        values.iter().for_each(|v| release_c_value(v));
+    }
+}
+
+// Releases the array of a list value. The values' own strings and lists must
+// already have been released by the receiver.
+#[no_mangle]
+pub extern "C" fn free_value_list(values: *mut CValue, len: usize) {
+    if values.is_null() {
+        return;
+    }
+    unsafe {
+        drop(Box::from_raw(ptr::slice_from_raw_parts_mut(values, len)));
+    }
+}
+
+// Data type enum shared with Go
+#[repr(C)]
+#[derive(Clone, Copy)]
+pub enum CDataTypeId {
+    Unknown = 0,
+    Boolean = 1,
+    Int8 = 2,
+    Int16 = 3,
+    Int32 = 4,
+    Int64 = 5,
+    UInt8 = 6,
+    UInt16 = 7,
+    UInt32 = 8,
+    UInt64 = 9,
+    Float32 = 10,
+    Float64 = 11,
+    String = 12,
+    Date = 13,
+    Datetime = 14,
+    List = 15,
+    Struct = 16,
+    Categorical = 17,
+    Enum = 18,
+    Decimal = 19,
+}
+
+// A data type. inner is the type of the values of a List and Unknown
+// otherwise. categories lists the values allowed by an Enum; it is only read
+// when converting from C and always null when converting to C. precision and
+// scale describe a Decimal; a precision of 0 lets Polars infer it. For a
+// List, categories, precision and scale describe its inner type.
+#[repr(C)]
+pub struct CDataType {
+    pub id: CDataTypeId,
+    pub inner: CDataTypeId,
+    pub categories: *const *const c_char,
+    pub categories_len: usize,
+    pub precision: usize,
+    pub scale: usize,
+}
+
+impl CDataType {
+    pub fn new(id: CDataTypeId) -> Self {
+        CDataType {
+            id,
+            inner: CDataTypeId::Unknown,
+            categories: ptr::null(),
+            categories_len: 0,
+            precision: 0,
+            scale: 0,
+        }
+    }
+}
+
+pub fn c_dtype_to_dtype(c_dtype: &CDataType) -> Result<DataType, String> {
+    match c_dtype.id {
+        CDataTypeId::Unknown => Err("Unknown data type".to_string()),
+        CDataTypeId::Boolean => Ok(DataType::Boolean),
+        CDataTypeId::Int8 => Ok(DataType::Int8),
+        CDataTypeId::Int16 => Ok(DataType::Int16),
+        CDataTypeId::Int32 => Ok(DataType::Int32),
+        CDataTypeId::Int64 => Ok(DataType::Int64),
+        CDataTypeId::UInt8 => Ok(DataType::UInt8),
+        CDataTypeId::UInt16 => Ok(DataType::UInt16),
+        CDataTypeId::UInt32 => Ok(DataType::UInt32),
+        CDataTypeId::UInt64 => Ok(DataType::UInt64),
+        CDataTypeId::Float32 => Ok(DataType::Float32),
+        CDataTypeId::Float64 => Ok(DataType::Float64),
+        CDataTypeId::String => Ok(DataType::String),
+        CDataTypeId::Date => Ok(DataType::Date),
+        CDataTypeId::Datetime => Ok(DataType::Datetime(TimeUnit::Microseconds, None)),
+        CDataTypeId::List => {
+            let inner = c_dtype_to_dtype(&CDataType {
+                id: c_dtype.inner,
+                inner: CDataTypeId::Unknown,
+                categories: c_dtype.categories,
+                categories_len: c_dtype.categories_len,
+                precision: c_dtype.precision,
+                scale: c_dtype.scale,
+            })?;
+            Ok(DataType::List(Box::new(inner)))
+        }
+        CDataTypeId::Struct => Err("Struct types need their fields".to_string()),
+        CDataTypeId::Categorical => Ok(DataType::Categorical(None, CategoricalOrdering::Physical)),
+        CDataTypeId::Enum => {
+            if c_dtype.categories.is_null() || c_dtype.categories_len == 0 {
+                return Err("Enum types need at least one category".to_string());
+            }
+            let c_categories =
+                unsafe { std::slice::from_raw_parts(c_dtype.categories, c_dtype.categories_len) };
+            let mut categories = Vec::with_capacity(c_categories.len());
+            for &category in c_categories {
+                match unsafe { CStr::from_ptr(category) }.to_str() {
+                    Ok(s) => categories.push(s),
+                    Err(_) => return Err("Invalid UTF-8 category".to_string()),
+                }
+            }
+            Ok(create_enum_dtype(Utf8ViewArray::from_slice_values(categories)))
+        }
+        CDataTypeId::Decimal => {
+            let precision = (c_dtype.precision > 0).then_some(c_dtype.precision);
+            Ok(DataType::Decimal(precision, Some(c_dtype.scale)))
+        }
+    }
+}
+
+pub fn dtype_to_c_dtype(dtype: &DataType) -> CDataType {
+    let id = match dtype {
+        DataType::Boolean => CDataTypeId::Boolean,
+        DataType::Int8 => CDataTypeId::Int8,
+        DataType::Int16 => CDataTypeId::Int16,
+        DataType::Int32 => CDataTypeId::Int32,
+        DataType::Int64 => CDataTypeId::Int64,
+        DataType::UInt8 => CDataTypeId::UInt8,
+        DataType::UInt16 => CDataTypeId::UInt16,
+        DataType::UInt32 => CDataTypeId::UInt32,
+        DataType::UInt64 => CDataTypeId::UInt64,
+        DataType::Float32 => CDataTypeId::Float32,
+        DataType::Float64 => CDataTypeId::Float64,
+        DataType::String => CDataTypeId::String,
+        DataType::Date => CDataTypeId::Date,
+        DataType::Datetime(_, _) => CDataTypeId::Datetime,
+        DataType::List(inner) => {
+            let c_inner = dtype_to_c_dtype(inner);
+            let mut c_dtype = CDataType::new(CDataTypeId::List);
+            c_dtype.inner = c_inner.id;
+            c_dtype.precision = c_inner.precision;
+            c_dtype.scale = c_inner.scale;
+            return c_dtype;
+        }
+        DataType::Struct(_) => CDataTypeId::Struct,
+        DataType::Decimal(precision, scale) => {
+            let mut c_dtype = CDataType::new(CDataTypeId::Decimal);
+            c_dtype.precision = precision.unwrap_or(0);
+            c_dtype.scale = scale.unwrap_or(0);
+            return c_dtype;
+        }
+        DataType::Categorical(_, _) => CDataTypeId::Categorical,
+        DataType::Enum(_, _) => CDataTypeId::Enum,
+        _ => CDataTypeId::Unknown,
+    };
+    CDataType::new(id)
 }
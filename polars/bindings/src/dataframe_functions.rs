@@ -7,9 +7,18 @@ use std::fs::File;
 use std::os::raw::c_char;
 use std::ptr;
 use std::rc::Rc;
+use std::sync::atomic::Ordering;
 
+// Reads a CSV file. Columns named in override_names are parsed with the
+// matching override_dtypes instead of the inferred type.
 #[no_mangle]
-pub extern "C" fn read_csv(path: *const c_char) -> *mut CDataFrame {
+pub extern "C" fn read_csv(
+    path: *const c_char,
+    override_names: *const *const c_char,
+    override_dtypes: *const CDataType,
+    overrides_len: c_int,
+    infer_decimals: c_int,
+) -> *mut CDataFrame {
     let c_str = unsafe { CStr::from_ptr(path) };
     let path_str = match c_str.to_str() {
         Ok(s) => s,
@@ -19,10 +28,22 @@ pub extern "C" fn read_csv(path: *const c_char) -> *mut CDataFrame {
         }
     };
 
-    match CsvReadOptions::default()
-        .try_into_reader_with_file_path(Some(path_str.into()))
-        .and_then(|reader| reader.finish())
-    {
+    let overrides = match unsafe { c_schema_overrides(override_names, override_dtypes, overrides_len) } {
+        Ok(overrides) => overrides,
+        Err(e) => {
+            set_last_error(&format!("Invalid schema overrides: {}", e));
+            return ptr::null_mut();
+        }
+    };
+
+    let read = |schema: Option<Schema>| {
+        CsvReadOptions::default()
+            .with_schema_overwrite(schema.map(Arc::new))
+            .try_into_reader_with_file_path(Some(path_str.into()))
+            .and_then(|reader| reader.finish())
+    };
+
+    match read_csv_source(read, overrides, infer_decimals != 0) {
         Ok(df) => polars_df_to_c_df(df),
         Err(e) => {
             set_last_error(&format!("Failed to read CSV: {}", e));
@@ -31,6 +52,92 @@ pub extern "C" fn read_csv(path: *const c_char) -> *mut CDataFrame {
     }
 }
 
+// Reads CSV data with the given schema overrides. With infer_decimals, the
+// Float64 columns that were not overridden and only hold plain decimal
+// numbers such as 12.50 are read again as Decimal(38, scale), scale being the
+// largest number of fractional digits, so they keep their exact values.
+fn read_csv_source<F>(read: F, overrides: Option<Schema>, infer_decimals: bool) -> PolarsResult<DataFrame>
+where
+    F: Fn(Option<Schema>) -> PolarsResult<DataFrame>,
+{
+    let df = read(overrides.clone())?;
+    if !infer_decimals {
+        return Ok(df);
+    }
+
+    let overridden = |name: &str| overrides.as_ref().is_some_and(|schema| schema.contains(name));
+    let candidates: Vec<PlSmallStr> = df
+        .get_columns()
+        .iter()
+        .filter(|column| column.dtype() == &DataType::Float64 && !overridden(column.name().as_str()))
+        .map(|column| column.name().clone())
+        .collect();
+    if candidates.is_empty() {
+        return Ok(df);
+    }
+
+    // The parsed floats have lost their digits, so look at the text again.
+    let mut as_text = overrides.clone().unwrap_or_default();
+    for name in &candidates {
+        as_text.with_column(name.clone(), DataType::String);
+    }
+    let text = read(Some(as_text))?;
+
+    let mut decimals = overrides.unwrap_or_default();
+    let mut found = false;
+    for name in &candidates {
+        let values = text.column(name.as_str())?.as_materialized_series().str()?;
+        if let Some(scale) = decimal_scale(values) {
+            decimals.with_column(name.clone(), DataType::Decimal(Some(38), Some(scale)));
+            found = true;
+        }
+    }
+    if !found {
+        return Ok(df);
+    }
+    read(Some(decimals))
+}
+
+// Returns the scale that holds every value exactly in a Decimal(38, scale), or
+// None when a value is not a plain decimal number, for example 1e-3 or NaN.
+fn decimal_scale(values: &StringChunked) -> Option<usize> {
+    let (mut integer_digits, mut scale, mut seen) = (0, 0, false);
+    for value in values.into_iter().flatten() {
+        let value = value.trim();
+        let digits = value.strip_prefix(['+', '-']).unwrap_or(value);
+        let (integer, fraction) = digits.split_once('.').unwrap_or((digits, ""));
+        if integer.is_empty() && fraction.is_empty() {
+            return None;
+        }
+        if !integer.bytes().chain(fraction.bytes()).all(|b| b.is_ascii_digit()) {
+            return None;
+        }
+        integer_digits = integer_digits.max(integer.trim_start_matches('0').len());
+        scale = scale.max(fraction.len());
+        seen = true;
+    }
+    (seen && integer_digits + scale <= 38).then_some(scale)
+}
+
+unsafe fn c_schema_overrides(
+    names: *const *const c_char,
+    dtypes: *const CDataType,
+    len: c_int,
+) -> Result<Option<Schema>, String> {
+    let names = c_strings_to_vec(names, len)?;
+    if names.is_empty() {
+        return Ok(None);
+    }
+    if dtypes.is_null() {
+        return Err("Data type array is null".to_string());
+    }
+    let mut schema = Schema::with_capacity(names.len());
+    for (name, c_dtype) in names.into_iter().zip(std::slice::from_raw_parts(dtypes, len as usize)) {
+        schema.with_column(name, c_dtype_to_dtype(c_dtype)?);
+    }
+    Ok(Some(schema))
+}
+
 #[no_mangle]
 pub extern "C" fn read_parquet(path: *const c_char) -> *mut CDataFrame {
     unsafe {
@@ -62,6 +169,116 @@ pub extern "C" fn read_parquet(path: *const c_char) -> *mut CDataFrame {
     }
 }
 
+#[repr(C)]
+pub struct CScanOptions {
+    hive_partitioning: u8,
+    include_file_paths: *const c_char,
+}
+
+impl CScanOptions {
+    // Name of the column holding each row's source file, if requested.
+    unsafe fn file_paths_column(&self) -> Result<Option<PlSmallStr>, String> {
+        if self.include_file_paths.is_null() {
+            return Ok(None);
+        }
+        match CStr::from_ptr(self.include_file_paths).to_str() {
+            Ok("") => Ok(None),
+            Ok(s) => Ok(Some(s.into())),
+            Err(_) => Err("Invalid UTF-8 file path column name".to_string()),
+        }
+    }
+}
+
+// Reads every Parquet file matching a glob pattern into a single DataFrame.
+#[no_mangle]
+pub extern "C" fn scan_parquet(pattern: *const c_char, options: CScanOptions) -> *mut CDataFrame {
+    unsafe {
+        let pattern_str = match CStr::from_ptr(pattern).to_str() {
+            Ok(s) => s,
+            Err(_) => {
+                set_last_error("Invalid UTF-8 path");
+                return ptr::null_mut();
+            }
+        };
+
+        let mut args = ScanArgsParquet::default();
+        args.hive_options.enabled = Some(options.hive_partitioning != 0);
+        args.include_file_paths = match options.file_paths_column() {
+            Ok(column) => column,
+            Err(e) => {
+                set_last_error(&e);
+                return ptr::null_mut();
+            }
+        };
+
+        match LazyFrame::scan_parquet(pattern_str, args).and_then(|lf| lf.collect()) {
+            Ok(df) => polars_df_to_c_df(df),
+            Err(e) => {
+                set_last_error(&format!("Failed to scan Parquet: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Reads several CSV files with the same columns into a single DataFrame.
+#[no_mangle]
+pub extern "C" fn read_csv_files(
+    paths: *const *const c_char,
+    paths_len: c_int,
+    options: CScanOptions,
+    override_names: *const *const c_char,
+    override_dtypes: *const CDataType,
+    overrides_len: c_int,
+    infer_decimals: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let paths_vec: Vec<std::path::PathBuf> = match c_strings_to_vec(paths, paths_len) {
+            Ok(paths) if !paths.is_empty() => paths.iter().map(|p| p.as_str().into()).collect(),
+            Ok(_) => {
+                set_last_error("At least one path is required");
+                return ptr::null_mut();
+            }
+            Err(e) => {
+                set_last_error(&format!("Invalid paths: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let file_paths_column = match options.file_paths_column() {
+            Ok(column) => column,
+            Err(e) => {
+                set_last_error(&e);
+                return ptr::null_mut();
+            }
+        };
+
+        let overrides = match c_schema_overrides(override_names, override_dtypes, overrides_len) {
+            Ok(overrides) => overrides,
+            Err(e) => {
+                set_last_error(&format!("Invalid schema overrides: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let read = |schema: Option<Schema>| {
+            LazyCsvReader::new_paths(paths_vec.clone().into())
+                .with_include_file_paths(file_paths_column.clone())
+                .with_dtype_overwrite(schema.map(Arc::new))
+                .finish()
+                .and_then(|lf| lf.collect())
+        };
+
+        match read_csv_source(read, overrides, infer_decimals != 0) {
+            Ok(df) => polars_df_to_c_df(df),
+            Err(e) => {
+                set_last_error(&format!("Failed to read CSV files: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
 #[no_mangle]
 pub extern "C" fn free_dataframe(df: *mut CDataFrame) {
     unsafe {
@@ -72,6 +289,7 @@ pub extern "C" fn free_dataframe(df: *mut CDataFrame) {
         if !c_df.inner.is_null() {
             drop(Box::from_raw(c_df.inner as *mut Rc<RefCell<DataFrame>>));
             drop(c_df);
+            LIVE_DATAFRAMES.fetch_sub(1, Ordering::Relaxed);
         }
     }
 }
@@ -113,6 +331,70 @@ pub extern "C" fn dataframe_height(df: *const CDataFrame) -> usize {
     }
 }
 
+#[no_mangle]
+pub extern "C" fn dataframe_estimated_size(df: *const CDataFrame) -> usize {
+    unsafe {
+        match c_df_to_polars_df_ref(df) {
+            Ok(rc_df) => rc_df.borrow().estimated_size(),
+            Err(_) => 0,
+        }
+    }
+}
+
+// Copies up to length rows starting at offset into out, which must have room
+// for length * width values laid out row by row. Returns the number of rows
+// written, or -1 on error.
+#[no_mangle]
+pub extern "C" fn dataframe_rows(
+    df: *const CDataFrame,
+    offset: usize,
+    length: usize,
+    out: *mut CValue,
+) -> i64 {
+    unsafe {
+        if out.is_null() {
+            set_last_error("Output values pointer is null");
+            return -1;
+        }
+        match c_df_to_polars_df_ref(df) {
+            Ok(rc_df) => {
+                let df = rc_df.borrow();
+                if offset >= df.height() {
+                    return 0;
+                }
+                let rows = length.min(df.height() - offset);
+                let width = df.width();
+                for (col_idx, column) in df.get_columns().iter().enumerate() {
+                    let series = column.as_materialized_series();
+                    for row in 0..rows {
+                        let value = match series.get(offset + row) {
+                            Ok(value) => value,
+                            Err(e) => {
+                                // Columns before col_idx are complete; this one
+                                // holds the rows before the failing one.
+                                for written_col in 0..=col_idx {
+                                    let written_rows = if written_col < col_idx { rows } else { row };
+                                    for written_row in 0..written_rows {
+                                        release_c_value(&*out.add(written_row * width + written_col));
+                                    }
+                                }
+                                set_last_error(&format!("Error reading rows: {}", e));
+                                return -1;
+                            }
+                        };
+                        ptr::write(out.add(row * width + col_idx), any_value_to_c_value(&value));
+                    }
+                }
+                rows as i64
+            }
+            Err(e) => {
+                set_last_error(&format!("Error reading rows: {}", e));
+                -1
+            }
+        }
+    }
+}
+
 #[no_mangle]
 pub extern "C" fn columns(df_ptr: *mut CDataFrame) -> *const c_char {
     unsafe {
@@ -257,35 +539,159 @@ pub extern "C" fn write_parquet(
         };
 
         match c_df_to_polars_df(df_ptr) {
-            Ok(rc_df) => {
-                let df = rc_df.borrow_mut();
-                let file = match File::create(path_str) {
-                    Ok(f) => f,
+            Ok(rc_df) => match write_parquet_file(&mut rc_df.borrow().clone(), path_str) {
+                Ok(()) => CString::new("Parquet written successfully")
+                    .unwrap()
+                    .into_raw(),
+                Err(e) => {
+                    set_last_error(&e);
+                    CString::new(e).unwrap().into_raw()
+                }
+            },
+            Err(e) => {
+                set_last_error(&format!("Error getting DataFrame: {}", e));
+                return ptr::null_mut();
+            }
+        }
+    }
+}
+
+// Writes df to a single Parquet file, replacing any existing file.
+fn write_parquet_file(df: &mut DataFrame, path: impl AsRef<std::path::Path>) -> Result<(), String> {
+    let file = File::create(path).map_err(|e| format!("Failed to create file: {}", e))?;
+    ParquetWriter::new(file)
+        .finish(df)
+        .map(|_| ())
+        .map_err(|e| format!("Failed to write Parquet: {}", e))
+}
+
+#[repr(C)]
+pub struct CPartitionOptions {
+    max_rows_per_file: usize,
+    keep_partition_columns: u8,
+}
+
+// Formats a partition key for a hive-style directory name.
+fn hive_partition_value(value: &AnyValue) -> String {
+    let raw = match value {
+        AnyValue::Null => return "__HIVE_DEFAULT_PARTITION__".to_string(),
+        AnyValue::String(s) => s.to_string(),
+        AnyValue::StringOwned(s) => s.to_string(),
+        other => other.to_string(),
+    };
+    raw.replace('%', "%25")
+        .replace('/', "%2F")
+        .replace('=', "%3D")
+}
+
+// Writes one directory per distinct combination of the partition columns,
+// laid out as dir/col=value/part-N.parquet. Fails without writing anything if
+// one of the partition directories already holds files. Returns 0 on success
+// and -1 on error.
+#[no_mangle]
+pub extern "C" fn write_parquet_partitioned(
+    df_ptr: *mut CDataFrame,
+    dir: *const c_char,
+    partition_by: *const *const c_char,
+    partition_by_len: c_int,
+    options: CPartitionOptions,
+) -> c_int {
+    unsafe {
+        let dir_str = match CStr::from_ptr(dir).to_str() {
+            Ok(s) => s,
+            Err(_) => {
+                set_last_error("Invalid UTF-8 path");
+                return -1;
+            }
+        };
+
+        let partition_cols = match c_strings_to_vec(partition_by, partition_by_len) {
+            Ok(cols) if !cols.is_empty() => cols,
+            Ok(_) => {
+                set_last_error("At least one partition column is required");
+                return -1;
+            }
+            Err(e) => {
+                set_last_error(&format!("Invalid partition columns: {}", e));
+                return -1;
+            }
+        };
+
+        let rc_df = match c_df_to_polars_df_ref(df_ptr) {
+            Ok(rc_df) => rc_df,
+            Err(e) => {
+                set_last_error(&format!("Error getting DataFrame: {}", e));
+                return -1;
+            }
+        };
+
+        let partitions = match rc_df.borrow().partition_by_stable(partition_cols.clone(), true) {
+            Ok(partitions) => partitions,
+            Err(e) => {
+                set_last_error(&format!("Failed to partition DataFrame: {}", e));
+                return -1;
+            }
+        };
+
+        let mut partition_dirs = Vec::with_capacity(partitions.len());
+        for partition in &partitions {
+            let mut partition_dir = std::path::PathBuf::from(dir_str);
+            for col_name in &partition_cols {
+                let value = match partition.column(col_name.as_str()).and_then(|c| c.get(0)) {
+                    Ok(value) => value,
                     Err(e) => {
-                        set_last_error(&format!("Failed to create file: {}", e));
-                        return ptr::null_mut();
+                        set_last_error(&format!("Failed to read partition key: {}", e));
+                        return -1;
                     }
                 };
+                partition_dir.push(format!("{}={}", col_name, hive_partition_value(&value)));
+            }
 
-                let writer = ParquetWriter::new(file);
+            // Leftover files from an earlier write would be read as part of
+            // the dataset, so refuse to write before touching anything.
+            let has_files = std::fs::read_dir(&partition_dir)
+                .map(|mut entries| entries.next().is_some())
+                .unwrap_or(false);
+            if has_files {
+                set_last_error(&format!(
+                    "Partition directory {} is not empty",
+                    partition_dir.display()
+                ));
+                return -1;
+            }
+            partition_dirs.push(partition_dir);
+        }
 
-                match writer.finish(&mut df.clone()) {
-                    Ok(_) => CString::new("Parquet written successfully")
-                        .unwrap()
-                        .into_raw(),
-                    Err(e) => {
-                        set_last_error(&format!("Failed to write Parquet: {}", e));
-                        CString::new(format!("Failed to write Parquet: {}", e))
-                            .unwrap()
-                            .into_raw()
-                    }
-                }
+        for (partition, partition_dir) in partitions.into_iter().zip(partition_dirs) {
+            if let Err(e) = std::fs::create_dir_all(&partition_dir) {
+                set_last_error(&format!("Failed to create directory: {}", e));
+                return -1;
             }
-            Err(e) => {
-                set_last_error(&format!("Error getting DataFrame: {}", e));
-                return ptr::null_mut();
+
+            let partition = if options.keep_partition_columns != 0 {
+                partition
+            } else {
+                partition.drop_many(partition_cols.iter().cloned())
+            };
+
+            let height = partition.height();
+            let rows_per_file = if options.max_rows_per_file == 0 {
+                height.max(1)
+            } else {
+                options.max_rows_per_file
+            };
+
+            for (file_idx, offset) in (0..height).step_by(rows_per_file).enumerate() {
+                let mut chunk = partition.slice(offset as i64, rows_per_file);
+                let file_path = partition_dir.join(format!("part-{}.parquet", file_idx));
+                if let Err(e) = write_parquet_file(&mut chunk, file_path) {
+                    set_last_error(&e);
+                    return -1;
+                }
             }
         }
+
+        0
     }
 }
 
@@ -537,14 +943,154 @@ pub enum CColumnType {
     Int64 = 1,
     Float64 = 2,
     Bool = 3,
+    Datetime = 4,
+    List = 5,
+    Decimal = 6,
 }
 
+// For List columns, data points to a CColumnSpec with the flattened values and
+// offsets holds length + 1 row boundaries into them. Decimal columns hold
+// decimal strings in data, parsed with precision and scale; a precision of 0
+// lets Polars infer it.
 #[repr(C)]
 pub struct CColumnSpec {
     name: *const c_char,
     column_type: CColumnType,
     data: *const std::ffi::c_void,
     length: c_int,
+    offsets: *const i64,
+    precision: c_int,
+    scale: c_int,
+}
+
+// Builds a Series from a column specification shared with Go.
+pub(crate) unsafe fn column_spec_to_series(spec: &CColumnSpec) -> Result<Series, String> {
+    if spec.name.is_null() || spec.length < 0 {
+        return Err("Invalid column specification".to_string());
+    }
+
+    // Allow null data only if length is 0 (empty column)
+    if spec.data.is_null() && spec.length > 0 {
+        return Err("Invalid column specification".to_string());
+    }
+
+    let name_cstr = CStr::from_ptr(spec.name);
+    let name = match name_cstr.to_str() {
+        Ok(s) => s,
+        Err(_) => return Err("Invalid UTF-8 column name".to_string()),
+    };
+
+    let series = match spec.column_type {
+        CColumnType::String => {
+            let mut values = Vec::new();
+            if spec.length == 0 {
+                // Empty column
+                let empty_values: Vec<Option<String>> = Vec::new();
+                Series::new(name.into(), empty_values)
+            } else {
+                let string_ptrs = spec.data as *const *const c_char;
+                for j in 0..spec.length {
+                    let str_ptr = *string_ptrs.add(j as usize);
+                    if str_ptr.is_null() {
+                        values.push(None);
+                    } else {
+                        let str_cstr = CStr::from_ptr(str_ptr);
+                        match str_cstr.to_str() {
+                            Ok(s) => values.push(Some(s.to_string())),
+                            Err(_) => return Err("Invalid UTF-8 string value".to_string()),
+                        }
+                    }
+                }
+                Series::new(name.into(), values)
+            }
+        }
+        CColumnType::Int64 => {
+            if spec.length == 0 {
+                let empty_values: Vec<i64> = Vec::new();
+                Series::new(name.into(), empty_values)
+            } else {
+                let int_data = spec.data as *const i64;
+                let values: Vec<i64> =
+                    std::slice::from_raw_parts(int_data, spec.length as usize).to_vec();
+                Series::new(name.into(), values)
+            }
+        }
+        CColumnType::Float64 => {
+            if spec.length == 0 {
+                let empty_values: Vec<f64> = Vec::new();
+                Series::new(name.into(), empty_values)
+            } else {
+                let float_data = spec.data as *const f64;
+                let values: Vec<f64> =
+                    std::slice::from_raw_parts(float_data, spec.length as usize).to_vec();
+                Series::new(name.into(), values)
+            }
+        }
+        CColumnType::Bool => {
+            if spec.length == 0 {
+                let empty_values: Vec<bool> = Vec::new();
+                Series::new(name.into(), empty_values)
+            } else {
+                let bool_data = spec.data as *const u8;
+                let mut values = Vec::new();
+                for j in 0..spec.length {
+                    let bool_val = *bool_data.add(j as usize) != 0;
+                    values.push(bool_val);
+                }
+                Series::new(name.into(), values)
+            }
+        }
+        CColumnType::Datetime => {
+            // Values are microseconds since the Unix epoch.
+            let values: Vec<i64> = if spec.length == 0 {
+                Vec::new()
+            } else {
+                let micros_data = spec.data as *const i64;
+                std::slice::from_raw_parts(micros_data, spec.length as usize).to_vec()
+            };
+            match Series::new(name.into(), values)
+                .cast(&DataType::Datetime(TimeUnit::Microseconds, None))
+            {
+                Ok(s) => s,
+                Err(e) => return Err(format!("Error creating datetime column: {}", e)),
+            }
+        }
+        CColumnType::List => {
+            if spec.data.is_null() || spec.offsets.is_null() {
+                return Err("Invalid list column specification".to_string());
+            }
+            let values = column_spec_to_series(&*(spec.data as *const CColumnSpec))?;
+            let offsets = std::slice::from_raw_parts(spec.offsets, spec.length as usize + 1);
+            let mut rows = Vec::with_capacity(spec.length as usize);
+            for bounds in offsets.windows(2) {
+                if bounds[0] < 0 || bounds[1] < bounds[0] || bounds[1] as usize > values.len() {
+                    return Err("Invalid list offsets".to_string());
+                }
+                rows.push(values.slice(bounds[0], (bounds[1] - bounds[0]) as usize));
+            }
+            if rows.is_empty() {
+                Series::new_empty(name.into(), &DataType::List(Box::new(values.dtype().clone())))
+            } else {
+                Series::new(name.into(), rows)
+            }
+        }
+        CColumnType::Decimal => {
+            if spec.precision < 0 || spec.scale < 0 {
+                return Err("Invalid decimal precision or scale".to_string());
+            }
+            let strings = column_spec_to_series(&CColumnSpec {
+                column_type: CColumnType::String,
+                ..*spec
+            })?;
+            let precision = (spec.precision > 0).then_some(spec.precision as usize);
+            match strings.strict_cast(&DataType::Decimal(precision, Some(spec.scale as usize))) {
+                Ok(s) => s,
+                Err(e) => return Err(format!("Error creating decimal column: {}", e)),
+            }
+        }
+    };
+
+    Ok(series)
 }
 
 #[no_mangle]
@@ -563,92 +1109,13 @@ pub extern "C" fn create_dataframe_mixed(
         for i in 0..column_count {
             let spec = &*column_specs.add(i as usize);
 
-            if spec.name.is_null() || spec.length < 0 {
-                set_last_error("Invalid column specification");
-                return ptr::null_mut();
-            }
-
-            // Allow null data only if length is 0 (empty column)
-            if spec.data.is_null() && spec.length > 0 {
-                set_last_error("Invalid column specification");
-                return ptr::null_mut();
-            }
-
-            let name_cstr = CStr::from_ptr(spec.name);
-            let name = match name_cstr.to_str() {
-                Ok(s) => s,
-                Err(_) => {
-                    set_last_error("Invalid UTF-8 column name");
+            match column_spec_to_series(spec) {
+                Ok(series) => series_vec.push(series.into()),
+                Err(e) => {
+                    set_last_error(&e);
                     return ptr::null_mut();
                 }
-            };
-
-            let series = match spec.column_type {
-                CColumnType::String => {
-                    let mut values = Vec::new();
-                    if spec.length == 0 {
-                        // Empty column
-                        let empty_values: Vec<Option<String>> = Vec::new();
-                        Series::new(name.into(), empty_values)
-                    } else {
-                        let string_ptrs = spec.data as *const *const c_char;
-                        for j in 0..spec.length {
-                            let str_ptr = *string_ptrs.add(j as usize);
-                            if str_ptr.is_null() {
-                                values.push(None);
-                            } else {
-                                let str_cstr = CStr::from_ptr(str_ptr);
-                                match str_cstr.to_str() {
-                                    Ok(s) => values.push(Some(s.to_string())),
-                                    Err(_) => {
-                                        set_last_error("Invalid UTF-8 string value");
-                                        return ptr::null_mut();
-                                    }
-                                }
-                            }
-                        }
-                        Series::new(name.into(), values)
-                    }
-                }
-                CColumnType::Int64 => {
-                    if spec.length == 0 {
-                        let empty_values: Vec<i64> = Vec::new();
-                        Series::new(name.into(), empty_values)
-                    } else {
-                        let int_data = spec.data as *const i64;
-                        let values: Vec<i64> =
-                            std::slice::from_raw_parts(int_data, spec.length as usize).to_vec();
-                        Series::new(name.into(), values)
-                    }
-                }
-                CColumnType::Float64 => {
-                    if spec.length == 0 {
-                        let empty_values: Vec<f64> = Vec::new();
-                        Series::new(name.into(), empty_values)
-                    } else {
-                        let float_data = spec.data as *const f64;
-                        let values: Vec<f64> =
-                            std::slice::from_raw_parts(float_data, spec.length as usize).to_vec();
-                        Series::new(name.into(), values)
-                    }
-                }
-                CColumnType::Bool => {
-                    if spec.length == 0 {
-                        let empty_values: Vec<bool> = Vec::new();
-                        Series::new(name.into(), empty_values)
-                    } else {
-                        let bool_data = spec.data as *const u8;
-                        let mut values = Vec::new();
-                        for j in 0..spec.length {
-                            let bool_val = *bool_data.add(j as usize) != 0;
-                            values.push(bool_val);
-                        }
-                        Series::new(name.into(), values)
-                    }
-                }
-            };
-
-            series_vec.push(series.into());
+            }
         }
 
         match DataFrame::new(series_vec) {
@@ -668,6 +1135,9 @@ pub enum CJoinType {
     Left = 1,
     Right = 2,
     Outer = 3,
+    Semi = 4,
+    Anti = 5,
+    Cross = 6,
 }
 
 impl From<CJoinType> for JoinType {
@@ -677,6 +1147,9 @@ impl From<CJoinType> for JoinType {
             CJoinType::Left => JoinType::Left,
             CJoinType::Right => JoinType::Right,
             CJoinType::Outer => JoinType::Full,
+            CJoinType::Semi => JoinType::Semi,
+            CJoinType::Anti => JoinType::Anti,
+            CJoinType::Cross => JoinType::Cross,
         }
     }
 }
@@ -866,3 +1339,998 @@ pub extern "C" fn join_dataframes_multiple_keys(
         }
     }
 }
+
+// Join validation enum
+#[repr(C)]
+pub enum CJoinValidation {
+    ManyToMany = 0,
+    OneToOne = 1,
+    OneToMany = 2,
+    ManyToOne = 3,
+}
+
+impl From<CJoinValidation> for JoinValidation {
+    fn from(validation: CJoinValidation) -> Self {
+        match validation {
+            CJoinValidation::ManyToMany => JoinValidation::ManyToMany,
+            CJoinValidation::OneToOne => JoinValidation::OneToOne,
+            CJoinValidation::OneToMany => JoinValidation::OneToMany,
+            CJoinValidation::ManyToOne => JoinValidation::ManyToOne,
+        }
+    }
+}
+
+// Join coalesce enum
+#[repr(C)]
+pub enum CJoinCoalesce {
+    Default = 0,
+    Coalesce = 1,
+    Keep = 2,
+}
+
+impl From<CJoinCoalesce> for JoinCoalesce {
+    fn from(coalesce: CJoinCoalesce) -> Self {
+        match coalesce {
+            CJoinCoalesce::Default => JoinCoalesce::JoinSpecific,
+            CJoinCoalesce::Coalesce => JoinCoalesce::CoalesceColumns,
+            CJoinCoalesce::Keep => JoinCoalesce::KeepColumns,
+        }
+    }
+}
+
+#[repr(C)]
+pub struct CJoinOptions {
+    suffix: *const c_char,
+    validation: CJoinValidation,
+    coalesce: CJoinCoalesce,
+    nulls_equal: u8,
+}
+
+pub(crate) unsafe fn c_exprs_to_exprs(
+    exprs_ptr: *const *mut CExpr,
+    exprs_len: c_int,
+) -> Result<Vec<Expr>, String> {
+    if exprs_len <= 0 {
+        return Ok(Vec::new());
+    }
+    if exprs_ptr.is_null() {
+        return Err("Expression array is null".to_string());
+    }
+    let exprs_slice = std::slice::from_raw_parts(exprs_ptr, exprs_len as usize);
+    exprs_slice
+        .iter()
+        .map(|&expr_ptr| c_expr_to_expr(expr_ptr))
+        .collect()
+}
+
+unsafe fn c_join_options_to_join_args(
+    join_type: CJoinType,
+    options: CJoinOptions,
+) -> Result<JoinArgs, String> {
+    // An empty or null suffix keeps the historical "_right" default
+    let suffix = if options.suffix.is_null() {
+        "_right"
+    } else {
+        match CStr::from_ptr(options.suffix).to_str() {
+            Ok("") => "_right",
+            Ok(s) => s,
+            Err(_) => return Err("Invalid UTF-8 in join suffix".to_string()),
+        }
+    };
+
+    let mut args = JoinArgs::new(join_type.into())
+        .with_suffix(Some(suffix.into()))
+        .with_coalesce(options.coalesce.into());
+    args.validation = options.validation.into();
+    args.join_nulls = options.nulls_equal != 0;
+    Ok(args)
+}
+
+#[no_mangle]
+pub extern "C" fn join_dataframes_exprs(
+    left_df: *mut CDataFrame,
+    right_df: *mut CDataFrame,
+    left_on: *const *mut CExpr,
+    left_on_len: c_int,
+    right_on: *const *mut CExpr,
+    right_on_len: c_int,
+    join_type: CJoinType,
+    options: CJoinOptions,
+) -> *mut CDataFrame {
+    unsafe {
+        if left_on_len != right_on_len {
+            set_last_error("Number of left_on and right_on keys must match");
+            return ptr::null_mut();
+        }
+        // Cross joins pair every row with every row and take no keys
+        let is_cross = matches!(join_type, CJoinType::Cross);
+        if is_cross && left_on_len > 0 {
+            set_last_error("Cross joins do not take join keys");
+            return ptr::null_mut();
+        }
+        if !is_cross && left_on_len <= 0 {
+            set_last_error("At least one join key is required");
+            return ptr::null_mut();
+        }
+
+        let (left_exprs, right_exprs) = match (
+            c_exprs_to_exprs(left_on, left_on_len),
+            c_exprs_to_exprs(right_on, right_on_len),
+        ) {
+            (Ok(left), Ok(right)) => (left, right),
+            (Err(e), _) | (_, Err(e)) => {
+                set_last_error(&format!("Error converting join keys: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let args = match c_join_options_to_join_args(join_type, options) {
+            Ok(args) => args,
+            Err(e) => {
+                set_last_error(&e);
+                return ptr::null_mut();
+            }
+        };
+
+        match (c_df_to_polars_df_ref(left_df), c_df_to_polars_df_ref(right_df)) {
+            (Ok(left_rc), Ok(right_rc)) => {
+                let join_result = left_rc
+                    .borrow()
+                    .clone()
+                    .lazy()
+                    .join(right_rc.borrow().clone().lazy(), left_exprs, right_exprs, args)
+                    .collect();
+
+                match join_result {
+                    Ok(result_df) => polars_df_to_c_df(result_df),
+                    Err(e) => {
+                        set_last_error(&format!("Join operation failed: {}", e));
+                        ptr::null_mut()
+                    }
+                }
+            }
+            _ => {
+                set_last_error("Error converting DataFrames");
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// As-of join strategy enum
+#[repr(C)]
+pub enum CAsofStrategy {
+    Backward = 0,
+    Forward = 1,
+    Nearest = 2,
+}
+
+impl From<CAsofStrategy> for AsofStrategy {
+    fn from(strategy: CAsofStrategy) -> Self {
+        match strategy {
+            CAsofStrategy::Backward => AsofStrategy::Backward,
+            CAsofStrategy::Forward => AsofStrategy::Forward,
+            CAsofStrategy::Nearest => AsofStrategy::Nearest,
+        }
+    }
+}
+
+pub(crate) unsafe fn c_strings_to_vec(strs: *const *const c_char, len: c_int) -> Result<Vec<PlSmallStr>, String> {
+    if len <= 0 {
+        return Ok(Vec::new());
+    }
+    if strs.is_null() {
+        return Err("String array is null".to_string());
+    }
+    std::slice::from_raw_parts(strs, len as usize)
+        .iter()
+        .map(|&s| match CStr::from_ptr(s).to_str() {
+            Ok(s) => Ok(s.into()),
+            Err(_) => Err("Invalid UTF-8 string".to_string()),
+        })
+        .collect()
+}
+
+// Matches each left row with the closest right row by key. The tolerance is
+// either a number for numeric keys or a duration such as "5m" for temporal
+// keys; an empty tolerance matches at any distance.
+#[no_mangle]
+pub extern "C" fn join_asof(
+    left_df: *mut CDataFrame,
+    right_df: *mut CDataFrame,
+    left_on: *const c_char,
+    right_on: *const c_char,
+    strategy: CAsofStrategy,
+    by: *const *const c_char,
+    by_len: c_int,
+    tolerance: *const c_char,
+) -> *mut CDataFrame {
+    unsafe {
+        let (left_on_str, right_on_str, tolerance_str) = match (
+            CStr::from_ptr(left_on).to_str(),
+            CStr::from_ptr(right_on).to_str(),
+            CStr::from_ptr(tolerance).to_str(),
+        ) {
+            (Ok(l), Ok(r), Ok(t)) => (l, r, t),
+            _ => {
+                set_last_error("Invalid UTF-8 in as-of join arguments");
+                return ptr::null_mut();
+            }
+        };
+
+        let by_cols = match c_strings_to_vec(by, by_len) {
+            Ok(cols) => cols,
+            Err(e) => {
+                set_last_error(&format!("Invalid by columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let mut options = AsOfOptions {
+            strategy: strategy.into(),
+            ..Default::default()
+        };
+        if !by_cols.is_empty() {
+            options.left_by = Some(by_cols.clone());
+            options.right_by = Some(by_cols);
+        }
+        if !tolerance_str.is_empty() {
+            if let Ok(v) = tolerance_str.parse::<i64>() {
+                options.tolerance = Some(AnyValue::Int64(v));
+            } else if let Ok(v) = tolerance_str.parse::<f64>() {
+                options.tolerance = Some(AnyValue::Float64(v));
+            } else if Duration::try_parse(tolerance_str).is_ok() {
+                options.tolerance_str = Some(tolerance_str.into());
+            } else {
+                set_last_error(&format!("Invalid as-of join tolerance: {}", tolerance_str));
+                return ptr::null_mut();
+            }
+        }
+
+        match (c_df_to_polars_df_ref(left_df), c_df_to_polars_df_ref(right_df)) {
+            (Ok(left_rc), Ok(right_rc)) => {
+                let join_result = left_rc
+                    .borrow()
+                    .clone()
+                    .lazy()
+                    .join(
+                        right_rc.borrow().clone().lazy(),
+                        [col(left_on_str)],
+                        [col(right_on_str)],
+                        JoinArgs::new(JoinType::AsOf(options)).with_suffix(Some("_right".into())),
+                    )
+                    .collect();
+
+                match join_result {
+                    Ok(result_df) => polars_df_to_c_df(result_df),
+                    Err(e) => {
+                        set_last_error(&format!("As-of join failed: {}", e));
+                        ptr::null_mut()
+                    }
+                }
+            }
+            _ => {
+                set_last_error("Error converting DataFrames");
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Joins every pair of rows for which all predicates hold. Right columns whose
+// names clash with left ones are suffixed with "_right" and must be referred
+// to by that name in the predicates.
+#[no_mangle]
+pub extern "C" fn join_where(
+    left_df: *mut CDataFrame,
+    right_df: *mut CDataFrame,
+    predicates: *const *mut CExpr,
+    predicates_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        if predicates_len <= 0 {
+            set_last_error("At least one join predicate is required");
+            return ptr::null_mut();
+        }
+
+        let predicate_exprs = match c_exprs_to_exprs(predicates, predicates_len) {
+            Ok(exprs) => exprs,
+            Err(e) => {
+                set_last_error(&format!("Error converting join predicates: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        match (c_df_to_polars_df_ref(left_df), c_df_to_polars_df_ref(right_df)) {
+            (Ok(left_rc), Ok(right_rc)) => {
+                let join_result = left_rc
+                    .borrow()
+                    .clone()
+                    .lazy()
+                    .join_builder()
+                    .with(right_rc.borrow().clone().lazy())
+                    .suffix("_right")
+                    .join_where(predicate_exprs)
+                    .collect();
+
+                match join_result {
+                    Ok(result_df) => polars_df_to_c_df(result_df),
+                    Err(e) => {
+                        set_last_error(&format!("Join where failed: {}", e));
+                        ptr::null_mut()
+                    }
+                }
+            }
+            _ => {
+                set_last_error("Error converting DataFrames");
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Concatenation method enum
+#[repr(C)]
+pub enum CConcatMethod {
+    Vertical = 0,
+    VerticalRelaxed = 1,
+    Diagonal = 2,
+    Horizontal = 3,
+}
+
+#[no_mangle]
+pub extern "C" fn concat_dataframes(
+    dfs: *const *mut CDataFrame,
+    dfs_len: c_int,
+    how: CConcatMethod,
+) -> *mut CDataFrame {
+    unsafe {
+        if dfs.is_null() || dfs_len <= 0 {
+            set_last_error("At least one DataFrame is required to concatenate");
+            return ptr::null_mut();
+        }
+
+        let mut frames: Vec<LazyFrame> = Vec::with_capacity(dfs_len as usize);
+        for &df_ptr in std::slice::from_raw_parts(dfs, dfs_len as usize) {
+            match c_df_to_polars_df_ref(df_ptr) {
+                Ok(rc_df) => frames.push(rc_df.borrow().clone().lazy()),
+                Err(e) => {
+                    set_last_error(&format!("Error converting DataFrame: {}", e));
+                    return ptr::null_mut();
+                }
+            }
+        }
+
+        let concatenated = match how {
+            CConcatMethod::Vertical => concat(frames, UnionArgs::default()),
+            CConcatMethod::VerticalRelaxed => concat(
+                frames,
+                UnionArgs {
+                    to_supertypes: true,
+                    ..Default::default()
+                },
+            ),
+            CConcatMethod::Diagonal => concat_lf_diagonal(frames, UnionArgs::default()),
+            CConcatMethod::Horizontal => concat_lf_horizontal(frames, UnionArgs::default()),
+        };
+
+        match concatenated.and_then(|lf| lf.collect()) {
+            Ok(result_df) => polars_df_to_c_df(result_df),
+            Err(e) => {
+                set_last_error(&format!("Concat failed: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Which row of each group of duplicates Unique keeps
+#[repr(C)]
+pub enum CUniqueKeep {
+    First = 0,
+    Last = 1,
+    None = 2,
+    Any = 3,
+}
+
+impl From<CUniqueKeep> for UniqueKeepStrategy {
+    fn from(keep: CUniqueKeep) -> Self {
+        match keep {
+            CUniqueKeep::First => UniqueKeepStrategy::First,
+            CUniqueKeep::Last => UniqueKeepStrategy::Last,
+            CUniqueKeep::None => UniqueKeepStrategy::None,
+            CUniqueKeep::Any => UniqueKeepStrategy::Any,
+        }
+    }
+}
+
+// Removes duplicate rows, comparing only the subset columns if any are given.
+#[no_mangle]
+pub extern "C" fn dataframe_unique(
+    df_ptr: *mut CDataFrame,
+    subset: *const *const c_char,
+    subset_len: c_int,
+    keep: CUniqueKeep,
+    maintain_order: u8,
+) -> *mut CDataFrame {
+    unsafe {
+        let subset_cols = match c_strings_to_vec(subset, subset_len) {
+            Ok(cols) if cols.is_empty() => None,
+            Ok(cols) => Some(cols),
+            Err(e) => {
+                set_last_error(&format!("Invalid subset columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        match c_df_to_polars_df_ref(df_ptr) {
+            Ok(rc_df) => {
+                let lf = rc_df.borrow().clone().lazy();
+                let unique_lf = if maintain_order != 0 {
+                    lf.unique_stable(subset_cols, keep.into())
+                } else {
+                    lf.unique(subset_cols, keep.into())
+                };
+                match unique_lf.collect() {
+                    Ok(unique_df) => polars_df_to_c_df(unique_df),
+                    Err(e) => {
+                        set_last_error(&format!("Unique error: {}", e));
+                        ptr::null_mut()
+                    }
+                }
+            }
+            Err(e) => {
+                set_last_error(&format!("Unique error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Returns a boolean Series marking every row that occurs more than once.
+#[no_mangle]
+pub extern "C" fn dataframe_is_duplicated(df_ptr: *const CDataFrame) -> *mut CSeries {
+    unsafe {
+        let result = c_df_to_polars_df_ref(df_ptr)
+            .and_then(|rc_df| rc_df.borrow().is_duplicated().map_err(|e| e.to_string()));
+        match result {
+            Ok(mask) => series_to_c_series(mask.with_name("is_duplicated".into()).into_series()),
+            Err(e) => {
+                set_last_error(&format!("Is duplicated error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Returns the number of distinct rows, or -1 on error.
+#[no_mangle]
+pub extern "C" fn dataframe_n_unique(df_ptr: *const CDataFrame) -> i64 {
+    unsafe {
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            rc_df
+                .borrow()
+                .clone()
+                .lazy()
+                .unique(None, UniqueKeepStrategy::Any)
+                .collect()
+                .map_err(|e| e.to_string())
+        });
+        match result {
+            Ok(unique_df) => unique_df.height() as i64,
+            Err(e) => {
+                set_last_error(&format!("Counting distinct rows failed: {}", e));
+                -1
+            }
+        }
+    }
+}
+
+// Pivot aggregation enum
+#[repr(C)]
+pub enum CPivotAgg {
+    First = 0,
+    Last = 1,
+    Sum = 2,
+    Mean = 3,
+    Median = 4,
+    Min = 5,
+    Max = 6,
+    Len = 7,
+}
+
+impl CPivotAgg {
+    // Pivot aggregations are applied to each cell's values, referred to by the
+    // empty column name.
+    fn to_expr(&self) -> Expr {
+        let values = col("");
+        match self {
+            CPivotAgg::First => values.first(),
+            CPivotAgg::Last => values.last(),
+            CPivotAgg::Sum => values.sum(),
+            CPivotAgg::Mean => values.mean(),
+            CPivotAgg::Median => values.median(),
+            CPivotAgg::Min => values.min(),
+            CPivotAgg::Max => values.max(),
+            CPivotAgg::Len => values.len(),
+        }
+    }
+}
+
+// Turns the distinct values of the on columns into new columns, one row per
+// distinct index. Empty index or values select all remaining columns.
+#[no_mangle]
+pub extern "C" fn dataframe_pivot(
+    df_ptr: *mut CDataFrame,
+    on: *const *const c_char,
+    on_len: c_int,
+    index: *const *const c_char,
+    index_len: c_int,
+    values: *const *const c_char,
+    values_len: c_int,
+    agg: CPivotAgg,
+) -> *mut CDataFrame {
+    unsafe {
+        let columns = c_strings_to_vec(on, on_len).and_then(|on| {
+            let index = c_strings_to_vec(index, index_len)?;
+            let values = c_strings_to_vec(values, values_len)?;
+            Ok((on, index, values))
+        });
+        let (on_cols, index_cols, value_cols) = match columns {
+            Ok(columns) => columns,
+            Err(e) => {
+                set_last_error(&format!("Invalid pivot columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+        if on_cols.is_empty() {
+            set_last_error("Pivot requires at least one on column");
+            return ptr::null_mut();
+        }
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            polars::lazy::frame::pivot::pivot_stable(
+                &rc_df.borrow(),
+                on_cols,
+                (!index_cols.is_empty()).then_some(index_cols),
+                (!value_cols.is_empty()).then_some(value_cols),
+                false,
+                Some(agg.to_expr()),
+                None,
+            )
+            .map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(pivoted) => polars_df_to_c_df(pivoted),
+            Err(e) => {
+                set_last_error(&format!("Pivot error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Turns the on columns into rows of variable/value pairs, repeating the index
+// columns. Empty on selects all non-index columns; empty names use the
+// "variable" and "value" defaults.
+#[no_mangle]
+pub extern "C" fn dataframe_unpivot(
+    df_ptr: *mut CDataFrame,
+    index: *const *const c_char,
+    index_len: c_int,
+    on: *const *const c_char,
+    on_len: c_int,
+    variable_name: *const c_char,
+    value_name: *const c_char,
+) -> *mut CDataFrame {
+    unsafe {
+        let columns = c_strings_to_vec(index, index_len).and_then(|index| {
+            let on = c_strings_to_vec(on, on_len)?;
+            Ok((index, on))
+        });
+        let (index_cols, on_cols) = match columns {
+            Ok(columns) => columns,
+            Err(e) => {
+                set_last_error(&format!("Invalid unpivot columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let name_or_default = |name: *const c_char| -> Option<PlSmallStr> {
+            if name.is_null() {
+                return None;
+            }
+            match CStr::from_ptr(name).to_str() {
+                Ok("") | Err(_) => None,
+                Ok(s) => Some(s.into()),
+            }
+        };
+
+        let args = UnpivotArgsIR {
+            on: on_cols,
+            index: index_cols,
+            variable_name: name_or_default(variable_name),
+            value_name: name_or_default(value_name),
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr)
+            .and_then(|rc_df| rc_df.borrow().unpivot2(args).map_err(|e| e.to_string()));
+
+        match result {
+            Ok(unpivoted) => polars_df_to_c_df(unpivoted),
+            Err(e) => {
+                set_last_error(&format!("Unpivot error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Turns each element of the list columns into its own row, repeating the
+// other columns. Exploded columns must have lists of equal lengths per row.
+#[no_mangle]
+pub extern "C" fn dataframe_explode(
+    df_ptr: *mut CDataFrame,
+    columns: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let cols = match c_strings_to_vec(columns, columns_len) {
+            Ok(cols) if !cols.is_empty() => cols,
+            Ok(_) => {
+                set_last_error("Explode requires at least one column");
+                return ptr::null_mut();
+            }
+            Err(e) => {
+                set_last_error(&format!("Invalid explode columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr)
+            .and_then(|rc_df| rc_df.borrow().explode(cols).map_err(|e| e.to_string()));
+
+        match result {
+            Ok(exploded) => polars_df_to_c_df(exploded),
+            Err(e) => {
+                set_last_error(&format!("Explode error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Replaces each struct column with one column per field.
+#[no_mangle]
+pub extern "C" fn dataframe_unnest(
+    df_ptr: *mut CDataFrame,
+    columns: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let cols = match c_strings_to_vec(columns, columns_len) {
+            Ok(cols) if !cols.is_empty() => cols,
+            Ok(_) => {
+                set_last_error("Unnest requires at least one column");
+                return ptr::null_mut();
+            }
+            Err(e) => {
+                set_last_error(&format!("Invalid unnest columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr)
+            .and_then(|rc_df| rc_df.borrow().unnest(cols).map_err(|e| e.to_string()));
+
+        match result {
+            Ok(unnested) => polars_df_to_c_df(unnested),
+            Err(e) => {
+                set_last_error(&format!("Unnest error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn dataframe_tail(df_ptr: *const CDataFrame, n: usize) -> *mut CDataFrame {
+    unsafe {
+        match c_df_to_polars_df_ref(df_ptr) {
+            Ok(rc_df) => polars_df_to_c_df(rc_df.borrow().tail(Some(n))),
+            Err(e) => {
+                set_last_error(&format!("Error getting tail: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// A negative offset counts from the end of the DataFrame.
+#[no_mangle]
+pub extern "C" fn dataframe_slice(
+    df_ptr: *const CDataFrame,
+    offset: i64,
+    length: usize,
+) -> *mut CDataFrame {
+    unsafe {
+        match c_df_to_polars_df_ref(df_ptr) {
+            Ok(rc_df) => polars_df_to_c_df(rc_df.borrow().slice(offset, length)),
+            Err(e) => {
+                set_last_error(&format!("Slice error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[repr(C)]
+pub struct CSampleOptions {
+    with_replacement: u8,
+    shuffle: u8,
+    has_seed: u8,
+    seed: u64,
+}
+
+impl CSampleOptions {
+    pub(crate) fn with_replacement(&self) -> bool {
+        self.with_replacement != 0
+    }
+
+    pub(crate) fn shuffle(&self) -> bool {
+        self.shuffle != 0
+    }
+
+    pub(crate) fn seed(&self) -> Option<u64> {
+        (self.has_seed != 0).then_some(self.seed)
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn dataframe_sample_n(
+    df_ptr: *const CDataFrame,
+    n: usize,
+    options: CSampleOptions,
+) -> *mut CDataFrame {
+    unsafe {
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            rc_df
+                .borrow()
+                .sample_n_literal(n, options.with_replacement(), options.shuffle(), options.seed())
+                .map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(sampled) => polars_df_to_c_df(sampled),
+            Err(e) => {
+                set_last_error(&format!("Sample error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn dataframe_sample_frac(
+    df_ptr: *const CDataFrame,
+    fraction: f64,
+    options: CSampleOptions,
+) -> *mut CDataFrame {
+    unsafe {
+        let frac = Series::new("frac".into(), [fraction]);
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            rc_df
+                .borrow()
+                .sample_frac(&frac, options.with_replacement(), options.shuffle(), options.seed())
+                .map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(sampled) => polars_df_to_c_df(sampled),
+            Err(e) => {
+                set_last_error(&format!("Sample error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Out-of-bounds indices are an error.
+#[no_mangle]
+pub extern "C" fn dataframe_gather(
+    df_ptr: *const CDataFrame,
+    indices: *const IdxSize,
+    indices_len: usize,
+) -> *mut CDataFrame {
+    unsafe {
+        if indices.is_null() && indices_len > 0 {
+            set_last_error("Index array is null");
+            return ptr::null_mut();
+        }
+        let idx: Vec<IdxSize> = if indices_len == 0 {
+            Vec::new()
+        } else {
+            std::slice::from_raw_parts(indices, indices_len).to_vec()
+        };
+        let idx = IdxCa::from_vec("idx".into(), idx);
+
+        let result = c_df_to_polars_df_ref(df_ptr)
+            .and_then(|rc_df| rc_df.borrow().take(&idx).map_err(|e| e.to_string()));
+
+        match result {
+            Ok(gathered) => polars_df_to_c_df(gathered),
+            Err(e) => {
+                set_last_error(&format!("Gather error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn dataframe_gather_every(
+    df_ptr: *const CDataFrame,
+    n: usize,
+    offset: usize,
+) -> *mut CDataFrame {
+    unsafe {
+        if n == 0 {
+            set_last_error("Gather every requires n to be positive");
+            return ptr::null_mut();
+        }
+
+        match c_df_to_polars_df_ref(df_ptr) {
+            Ok(rc_df) => polars_df_to_c_df(rc_df.borrow().gather_every(n, offset)),
+            Err(e) => {
+                set_last_error(&format!("Gather every error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Fails if any of the columns does not exist.
+#[no_mangle]
+pub extern "C" fn dataframe_drop(
+    df_ptr: *const CDataFrame,
+    columns: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let cols = match c_strings_to_vec(columns, columns_len) {
+            Ok(cols) => cols,
+            Err(e) => {
+                set_last_error(&format!("Invalid drop columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            let df = rc_df.borrow();
+            for name in &cols {
+                df.column(name).map_err(|e| e.to_string())?;
+            }
+            Ok(df.drop_many(cols))
+        });
+
+        match result {
+            Ok(dropped) => polars_df_to_c_df(dropped),
+            Err(e) => {
+                set_last_error(&format!("Drop error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Renames are applied together, so two columns can swap names.
+#[no_mangle]
+pub extern "C" fn dataframe_rename(
+    df_ptr: *const CDataFrame,
+    existing: *const *const c_char,
+    new: *const *const c_char,
+    len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let (existing, new) = match (c_strings_to_vec(existing, len), c_strings_to_vec(new, len)) {
+            (Ok(existing), Ok(new)) => (existing, new),
+            (Err(e), _) | (_, Err(e)) => {
+                set_last_error(&format!("Invalid rename columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            let mut df = rc_df.borrow().clone();
+            let mut names: Vec<PlSmallStr> = df.get_column_names_owned();
+            for (old, new) in existing.iter().zip(new) {
+                match df.get_column_index(old) {
+                    Some(i) => names[i] = new,
+                    None => return Err(format!("column '{}' not found", old)),
+                }
+            }
+            df.set_column_names(names).map_err(|e| e.to_string())?;
+            Ok(df)
+        });
+
+        match result {
+            Ok(renamed) => polars_df_to_c_df(renamed),
+            Err(e) => {
+                set_last_error(&format!("Rename error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Inserts a UInt32 column numbering the rows from offset as the first column.
+#[no_mangle]
+pub extern "C" fn dataframe_with_row_index(
+    df_ptr: *const CDataFrame,
+    name: *const c_char,
+    offset: IdxSize,
+) -> *mut CDataFrame {
+    unsafe {
+        let name_str = match CStr::from_ptr(name).to_str() {
+            Ok(s) => s,
+            Err(_) => {
+                set_last_error("Invalid UTF-8 column name");
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            rc_df
+                .borrow()
+                .with_row_index(name_str.into(), Some(offset))
+                .map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(indexed) => polars_df_to_c_df(indexed),
+            Err(e) => {
+                set_last_error(&format!("Row index error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Moves the given columns to the front in that order, followed by the
+// remaining columns in their original order.
+#[no_mangle]
+pub extern "C" fn dataframe_reorder(
+    df_ptr: *const CDataFrame,
+    columns: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        let cols = match c_strings_to_vec(columns, columns_len) {
+            Ok(cols) => cols,
+            Err(e) => {
+                set_last_error(&format!("Invalid reorder columns: {}", e));
+                return ptr::null_mut();
+            }
+        };
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            let df = rc_df.borrow();
+            let mut order = cols.clone();
+            for name in df.get_column_names() {
+                if !cols.contains(name) {
+                    order.push(name.clone());
+                }
+            }
+            df.select(order).map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(reordered) => polars_df_to_c_df(reordered),
+            Err(e) => {
+                set_last_error(&format!("Reorder error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
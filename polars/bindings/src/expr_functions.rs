@@ -1,8 +1,10 @@
 use crate::conversions::*;
+use crate::dataframe_functions::{c_exprs_to_exprs, c_strings_to_vec, CSampleOptions};
 use crate::LAST_ERROR;
 use polars::prelude::*;
-use std::ffi::{c_char, CStr};
+use std::ffi::{c_char, c_int, CStr};
 use std::ptr;
+use std::sync::atomic::Ordering;
 
 #[no_mangle]
 pub extern "C" fn col(name: *const c_char) -> *mut CExpr {
@@ -262,7 +264,11 @@ pub extern "C" fn free_expr(expr: *mut CExpr) {
             return;
         }
 
-        let _expr = Box::from_raw(expr);
+        let c_expr = Box::from_raw(expr);
+        if !c_expr.inner.is_null() {
+            drop(Box::from_raw(c_expr.inner as *mut Expr));
+            LIVE_EXPRS.fetch_sub(1, Ordering::Relaxed);
+        }
     }
 }
 
@@ -384,6 +390,201 @@ pub extern "C" fn expr_std(expr_ptr: *mut CExpr) -> *mut CExpr {
     }
 }
 
+#[no_mangle]
+pub extern "C" fn expr_median(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().median();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_var(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().var(1);
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_first(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().first();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_last(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().last();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_n_unique(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().n_unique();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+// Distinct values in order of first appearance
+#[no_mangle]
+pub extern "C" fn expr_unique(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().unique_stable();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_is_unique(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().is_unique();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_is_first_distinct(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().is_first_distinct();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_null_count(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().null_count();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_implode(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().implode();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_len(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().len();
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+// Quantile interpolation enum
+#[repr(C)]
+pub enum CQuantileMethod {
+    Nearest = 0,
+    Lower = 1,
+    Higher = 2,
+    Midpoint = 3,
+    Linear = 4,
+}
+
+impl From<CQuantileMethod> for QuantileMethod {
+    fn from(method: CQuantileMethod) -> Self {
+        match method {
+            CQuantileMethod::Nearest => QuantileMethod::Nearest,
+            CQuantileMethod::Lower => QuantileMethod::Lower,
+            CQuantileMethod::Higher => QuantileMethod::Higher,
+            CQuantileMethod::Midpoint => QuantileMethod::Midpoint,
+            CQuantileMethod::Linear => QuantileMethod::Linear,
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_quantile(
+    expr_ptr: *mut CExpr,
+    quantile: f64,
+    method: CQuantileMethod,
+) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().quantile(lit(quantile), method.into());
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
 #[no_mangle]
 pub extern "C" fn expr_count() -> *mut CExpr {
     expr_to_c_expr(len().alias("count"))
@@ -472,3 +673,536 @@ pub extern "C" fn col_le_f64(expr_ptr: *mut CExpr, value: f64) -> *mut CExpr {
         }
     }
 }
+
+// Comparisons between two expressions
+
+#[no_mangle]
+pub extern "C" fn expr_gt(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.gt(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_lt(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.lt(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_eq(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.eq(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_ne(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.neq(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_ge(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.gt_eq(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_le(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let left_result = c_expr_to_expr(left_expr);
+        let right_result = c_expr_to_expr(right_expr);
+        match (left_result, right_result) {
+            (Ok(left), Ok(right)) => {
+                let new_expr = left.lt_eq(right);
+                expr_to_c_expr(new_expr)
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+// Fixed-size rolling windows only produce a value once the window is full,
+// matching the polars default of min_periods == window_size.
+fn rolling_options(window_size: usize) -> RollingOptionsFixedWindow {
+    RollingOptionsFixedWindow {
+        window_size,
+        min_periods: window_size,
+        ..Default::default()
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_rolling_sum(expr_ptr: *mut CExpr, window_size: usize) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().rolling_sum(rolling_options(window_size));
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_rolling_mean(expr_ptr: *mut CExpr, window_size: usize) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().rolling_mean(rolling_options(window_size));
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_rolling_min(expr_ptr: *mut CExpr, window_size: usize) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().rolling_min(rolling_options(window_size));
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_rolling_max(expr_ptr: *mut CExpr, window_size: usize) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().rolling_max(rolling_options(window_size));
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_rolling_std(expr_ptr: *mut CExpr, window_size: usize) -> *mut CExpr {
+    unsafe {
+        let expr_result = c_expr_to_expr(expr_ptr);
+        match expr_result {
+            Ok(expr) => {
+                let new_expr = expr.clone().rolling_std(rolling_options(window_size));
+                expr_to_c_expr(new_expr)
+            }
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+// Applies f to the expression, returning null if it is invalid.
+unsafe fn map_c_expr(expr_ptr: *mut CExpr, f: impl FnOnce(Expr) -> Expr) -> *mut CExpr {
+    match c_expr_to_expr(expr_ptr) {
+        Ok(expr) => expr_to_c_expr(f(expr.clone())),
+        Err(_) => ptr::null_mut(),
+    }
+}
+
+// String namespace
+
+#[no_mangle]
+pub extern "C" fn expr_str_split(expr_ptr: *mut CExpr, by: *const c_char) -> *mut CExpr {
+    unsafe {
+        let by_str = CStr::from_ptr(by).to_str().unwrap_or_default();
+        map_c_expr(expr_ptr, |expr| expr.str().split(lit(by_str)))
+    }
+}
+
+// List namespace
+
+#[no_mangle]
+pub extern "C" fn expr_list_len(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.list().len()) }
+}
+
+// Negative indices count from the end; out of bounds indices give null.
+#[no_mangle]
+pub extern "C" fn expr_list_get(expr_ptr: *mut CExpr, index: i64) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.list().get(lit(index), true)) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_list_contains(expr_ptr: *mut CExpr, item_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        match c_expr_to_expr(item_ptr) {
+            Ok(item) => map_c_expr(expr_ptr, |expr| expr.list().contains(item.clone())),
+            Err(_) => ptr::null_mut(),
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_list_join(expr_ptr: *mut CExpr, separator: *const c_char) -> *mut CExpr {
+    unsafe {
+        let separator_str = CStr::from_ptr(separator).to_str().unwrap_or_default();
+        map_c_expr(expr_ptr, |expr| expr.list().join(lit(separator_str), true))
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_list_sum(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.list().sum()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_list_mean(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.list().mean()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_list_sort(expr_ptr: *mut CExpr, descending: u8) -> *mut CExpr {
+    unsafe {
+        let options = SortOptions::default().with_order_descending(descending != 0);
+        map_c_expr(expr_ptr, |expr| expr.list().sort(options))
+    }
+}
+
+// Distinct values of each list, in order of first appearance.
+#[no_mangle]
+pub extern "C" fn expr_list_unique(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.list().unique_stable()) }
+}
+
+// Struct namespace
+
+// Combines the expressions into a single struct column, one field per expression.
+#[no_mangle]
+pub extern "C" fn expr_as_struct(exprs_ptr: *const *mut CExpr, exprs_len: c_int) -> *mut CExpr {
+    unsafe {
+        match c_exprs_to_exprs(exprs_ptr, exprs_len) {
+            Ok(exprs) if !exprs.is_empty() => expr_to_c_expr(as_struct(exprs)),
+            Ok(_) => {
+                *LAST_ERROR.lock().unwrap() = Some("AsStruct requires at least one expression".to_string());
+                ptr::null_mut()
+            }
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_struct_field(expr_ptr: *mut CExpr, name: *const c_char) -> *mut CExpr {
+    unsafe {
+        let name_str = CStr::from_ptr(name).to_str().unwrap_or_default();
+        map_c_expr(expr_ptr, |expr| expr.struct_().field_by_name(name_str))
+    }
+}
+
+// Casts fail at evaluation if a value cannot be represented in the new type.
+#[no_mangle]
+pub extern "C" fn expr_cast(expr_ptr: *mut CExpr, dtype: CDataType) -> *mut CExpr {
+    unsafe {
+        match c_dtype_to_dtype(&dtype) {
+            Ok(dtype) => map_c_expr(expr_ptr, |expr| expr.strict_cast(dtype)),
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Categorical namespace
+
+#[no_mangle]
+pub extern "C" fn expr_cat_get_categories(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.cat().get_categories()) }
+}
+
+// Row selection
+
+#[no_mangle]
+pub extern "C" fn expr_head(expr_ptr: *mut CExpr, n: usize) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.head(Some(n))) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_tail(expr_ptr: *mut CExpr, n: usize) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.tail(Some(n))) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_sample_n(expr_ptr: *mut CExpr, n: usize, options: CSampleOptions) -> *mut CExpr {
+    unsafe {
+        map_c_expr(expr_ptr, |expr| {
+            expr.sample_n(
+                lit(n as u64),
+                options.with_replacement(),
+                options.shuffle(),
+                options.seed(),
+            )
+        })
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_sample_frac(
+    expr_ptr: *mut CExpr,
+    fraction: f64,
+    options: CSampleOptions,
+) -> *mut CExpr {
+    unsafe {
+        map_c_expr(expr_ptr, |expr| {
+            expr.sample_frac(
+                lit(fraction),
+                options.with_replacement(),
+                options.shuffle(),
+                options.seed(),
+            )
+        })
+    }
+}
+
+// Column selection
+
+#[no_mangle]
+pub extern "C" fn expr_all() -> *mut CExpr {
+    expr_to_c_expr(all())
+}
+
+#[no_mangle]
+pub extern "C" fn expr_exclude(
+    expr_ptr: *mut CExpr,
+    columns: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CExpr {
+    unsafe {
+        match c_strings_to_vec(columns, columns_len) {
+            Ok(cols) => map_c_expr(expr_ptr, |expr| expr.exclude(cols)),
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Selectors
+
+#[no_mangle]
+pub extern "C" fn selector_by_dtype(dtypes: *const CDataType, dtypes_len: usize) -> *mut CExpr {
+    unsafe {
+        if dtypes.is_null() || dtypes_len == 0 {
+            *LAST_ERROR.lock().unwrap() = Some("Selector requires at least one data type".to_string());
+            return ptr::null_mut();
+        }
+        let c_dtypes = std::slice::from_raw_parts(dtypes, dtypes_len);
+        match c_dtypes.iter().map(c_dtype_to_dtype).collect::<Result<Vec<_>, _>>() {
+            Ok(dtypes) => expr_to_c_expr(dtype_cols(dtypes)),
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+fn to_selector(expr: Expr) -> Selector {
+    match expr {
+        Expr::Selector(selector) => selector,
+        expr => Selector::new(expr),
+    }
+}
+
+unsafe fn combine_selectors(
+    a_ptr: *mut CExpr,
+    b_ptr: *mut CExpr,
+    op: fn(Selector, Selector) -> Selector,
+) -> *mut CExpr {
+    match (c_expr_to_expr(a_ptr), c_expr_to_expr(b_ptr)) {
+        (Ok(a), Ok(b)) => {
+            let selector = op(to_selector(a.clone()), to_selector(b.clone()));
+            expr_to_c_expr(Expr::Selector(selector))
+        }
+        _ => ptr::null_mut(),
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn selector_union(a_ptr: *mut CExpr, b_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { combine_selectors(a_ptr, b_ptr, |a, b| a + b) }
+}
+
+#[no_mangle]
+pub extern "C" fn selector_intersection(a_ptr: *mut CExpr, b_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { combine_selectors(a_ptr, b_ptr, |a, b| a & b) }
+}
+
+#[no_mangle]
+pub extern "C" fn selector_difference(a_ptr: *mut CExpr, b_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { combine_selectors(a_ptr, b_ptr, |a, b| a - b) }
+}
+
+// Math
+
+#[no_mangle]
+pub extern "C" fn expr_abs(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.abs()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_round(expr_ptr: *mut CExpr, decimals: u32) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.round(decimals)) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_floor(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.floor()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_ceil(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.ceil()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_sqrt(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.sqrt()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_log(expr_ptr: *mut CExpr, base: f64) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.log(base)) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_exp(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.exp()) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_sign(expr_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe { map_c_expr(expr_ptr, |expr| expr.sign()) }
+}
+
+unsafe fn binary_c_expr(
+    left_expr: *mut CExpr,
+    right_expr: *mut CExpr,
+    op: fn(Expr, Expr) -> Expr,
+) -> *mut CExpr {
+    match (c_expr_to_expr(left_expr), c_expr_to_expr(right_expr)) {
+        (Ok(left), Ok(right)) => expr_to_c_expr(op(left.clone(), right.clone())),
+        _ => ptr::null_mut(),
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_pow(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe { binary_c_expr(left_expr, right_expr, |base, exponent| base.pow(exponent)) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_mod(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe { binary_c_expr(left_expr, right_expr, |left, right| left % right) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_floor_div(left_expr: *mut CExpr, right_expr: *mut CExpr) -> *mut CExpr {
+    unsafe { binary_c_expr(left_expr, right_expr, |left, right| left.floor_div(right)) }
+}
+
+#[no_mangle]
+pub extern "C" fn expr_clip(
+    expr_ptr: *mut CExpr,
+    lower_ptr: *mut CExpr,
+    upper_ptr: *mut CExpr,
+) -> *mut CExpr {
+    unsafe {
+        match (c_expr_to_expr(lower_ptr), c_expr_to_expr(upper_ptr)) {
+            (Ok(lower), Ok(upper)) => {
+                map_c_expr(expr_ptr, |expr| expr.clip(lower.clone(), upper.clone()))
+            }
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+// Trigonometric function enum
+#[repr(C)]
+pub enum CTrigFunction {
+    Sin = 0,
+    Cos = 1,
+    Tan = 2,
+    Arcsin = 3,
+    Arccos = 4,
+    Arctan = 5,
+    Sinh = 6,
+    Cosh = 7,
+    Tanh = 8,
+}
+
+#[no_mangle]
+pub extern "C" fn expr_trig(expr_ptr: *mut CExpr, function: CTrigFunction) -> *mut CExpr {
+    unsafe {
+        map_c_expr(expr_ptr, |expr| match function {
+            CTrigFunction::Sin => expr.sin(),
+            CTrigFunction::Cos => expr.cos(),
+            CTrigFunction::Tan => expr.tan(),
+            CTrigFunction::Arcsin => expr.arcsin(),
+            CTrigFunction::Arccos => expr.arccos(),
+            CTrigFunction::Arctan => expr.arctan(),
+            CTrigFunction::Sinh => expr.sinh(),
+            CTrigFunction::Cosh => expr.cosh(),
+            CTrigFunction::Tanh => expr.tanh(),
+        })
+    }
+}
@@ -1,8 +1,10 @@
 use crate::conversions::*;
+use crate::dataframe_functions::c_strings_to_vec;
 use crate::LAST_ERROR;
 use polars::prelude::*;
-use std::ffi::{c_char, CStr};
+use std::ffi::{c_char, c_int, CStr};
 use std::ptr;
+use std::sync::atomic::Ordering;
 
 #[no_mangle]
 pub extern "C" fn group_by(df_ptr: *mut CDataFrame, columns_ptr: *const c_char) -> *mut CGroupBy {
@@ -27,13 +29,88 @@ pub extern "C" fn group_by(df_ptr: *mut CDataFrame, columns_ptr: *const c_char)
     }
 }
 
+#[no_mangle]
+pub extern "C" fn group_by_stable(
+    df_ptr: *mut CDataFrame,
+    columns_ptr: *const *const c_char,
+    columns_len: c_int,
+) -> *mut CGroupBy {
+    unsafe {
+        match c_df_to_polars_df(df_ptr) {
+            Ok(rc_df) => {
+                let df = rc_df.borrow();
+                let columns: Vec<Expr> = match c_strings_to_vec(columns_ptr, columns_len) {
+                    Ok(names) => names.into_iter().map(col).collect(),
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid group by columns: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                let lazy_group_by = df.clone().lazy().group_by_stable(columns);
+
+                groupby_to_c_groupby(lazy_group_by)
+            }
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(format!("Group by error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn group_by_exprs(
+    df_ptr: *mut CDataFrame,
+    exprs_ptr: *mut *mut CExpr,
+    exprs_len: i32,
+    maintain_order: u8,
+) -> *mut CGroupBy {
+    unsafe {
+        match c_df_to_polars_df(df_ptr) {
+            Ok(rc_df) => {
+                let mut exprs = Vec::new();
+                for i in 0..exprs_len {
+                    let expr_ptr = *exprs_ptr.add(i as usize);
+                    match c_expr_to_expr(expr_ptr) {
+                        Ok(expr) => exprs.push(expr),
+                        Err(e) => {
+                            *LAST_ERROR.lock().unwrap() =
+                                Some(format!("Error converting expression: {}", e));
+                            return ptr::null_mut();
+                        }
+                    }
+                }
+
+                let df = rc_df.borrow();
+                let lazy_df = df.clone().lazy();
+                let lazy_group_by = if maintain_order != 0 {
+                    lazy_df.group_by_stable(exprs)
+                } else {
+                    lazy_df.group_by(exprs)
+                };
+
+                groupby_to_c_groupby(lazy_group_by)
+            }
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(format!("Group by error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
 #[no_mangle]
 pub extern "C" fn free_groupby(groupby: *mut CGroupBy) {
     unsafe {
         if groupby.is_null() {
             return;
         }
-        let _groupby = Box::from_raw(groupby);
+        let c_gb = Box::from_raw(groupby);
+        if !c_gb.inner.is_null() {
+            drop(Box::from_raw(c_gb.inner as *mut LazyGroupBy));
+            LIVE_GROUPBYS.fetch_sub(1, Ordering::Relaxed);
+        }
     }
 }
 
@@ -44,13 +121,13 @@ pub extern "C" fn groupby_agg(
     exprs_len: i32,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let mut exprs = Vec::new();
         for i in 0..exprs_len {
@@ -81,13 +158,13 @@ pub extern "C" fn groupby_sum(
     column_ptr: *const c_char,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let column_str = CStr::from_ptr(column_ptr).to_str().unwrap();
 
@@ -107,13 +184,13 @@ pub extern "C" fn groupby_mean(
     column_ptr: *const c_char,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let column_str = CStr::from_ptr(column_ptr).to_str().unwrap();
 
@@ -130,13 +207,13 @@ pub extern "C" fn groupby_mean(
 #[no_mangle]
 pub extern "C" fn groupby_count(groupby_ptr: *mut CGroupBy) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         match lazy_groupby.agg([len().alias("count")]).collect() {
             Ok(df) => polars_df_to_c_df(df),
@@ -154,13 +231,13 @@ pub extern "C" fn groupby_min(
     column_ptr: *const c_char,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let column_str = CStr::from_ptr(column_ptr).to_str().unwrap();
 
@@ -180,13 +257,13 @@ pub extern "C" fn groupby_max(
     column_ptr: *const c_char,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let column_str = CStr::from_ptr(column_ptr).to_str().unwrap();
 
@@ -206,13 +283,13 @@ pub extern "C" fn groupby_std(
     column_ptr: *const c_char,
 ) -> *mut CDataFrame {
     unsafe {
-        if groupby_ptr.is_null() || (*groupby_ptr).inner.is_null() {
-            *LAST_ERROR.lock().unwrap() = Some("GroupBy pointer is null".to_string());
-            return ptr::null_mut();
-        }
-
-        let gb_ptr = (*groupby_ptr).inner as *mut LazyGroupBy;
-        let lazy_groupby = Box::from_raw(gb_ptr);
+        let lazy_groupby = match c_groupby_to_lazy_groupby(groupby_ptr) {
+            Ok(gb) => gb,
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(e);
+                return ptr::null_mut();
+            }
+        };
 
         let column_str = CStr::from_ptr(column_ptr).to_str().unwrap();
 
@@ -225,3 +302,159 @@ pub extern "C" fn groupby_std(
         }
     }
 }
+
+#[no_mangle]
+pub extern "C" fn group_by_dynamic(
+    df_ptr: *mut CDataFrame,
+    index_column_ptr: *const c_char,
+    every_ptr: *const c_char,
+    period_ptr: *const c_char,
+    offset_ptr: *const c_char,
+    by_ptr: *const *const c_char,
+    by_len: c_int,
+) -> *mut CGroupBy {
+    unsafe {
+        match c_df_to_polars_df(df_ptr) {
+            Ok(rc_df) => {
+                let df = rc_df.borrow();
+                let (index_column, every_str, period_str, offset_str) = match (
+                    CStr::from_ptr(index_column_ptr).to_str(),
+                    CStr::from_ptr(every_ptr).to_str(),
+                    CStr::from_ptr(period_ptr).to_str(),
+                    CStr::from_ptr(offset_ptr).to_str(),
+                ) {
+                    (Ok(i), Ok(e), Ok(p), Ok(o)) => (i, e, p, o),
+                    _ => {
+                        *LAST_ERROR.lock().unwrap() =
+                            Some("Invalid UTF-8 in dynamic group by arguments".to_string());
+                        return ptr::null_mut();
+                    }
+                };
+                let by: Vec<Expr> = match c_strings_to_vec(by_ptr, by_len) {
+                    Ok(names) => names.into_iter().map(col).collect(),
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid by columns: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                let every = match Duration::try_parse(every_str) {
+                    Ok(d) => d,
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid every: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                // Like polars, the window length defaults to the window interval.
+                let period = if period_str.is_empty() {
+                    every
+                } else {
+                    match Duration::try_parse(period_str) {
+                        Ok(d) => d,
+                        Err(e) => {
+                            *LAST_ERROR.lock().unwrap() = Some(format!("Invalid period: {}", e));
+                            return ptr::null_mut();
+                        }
+                    }
+                };
+
+                let offset = if offset_str.is_empty() {
+                    Duration::new(0)
+                } else {
+                    match Duration::try_parse(offset_str) {
+                        Ok(d) => d,
+                        Err(e) => {
+                            *LAST_ERROR.lock().unwrap() = Some(format!("Invalid offset: {}", e));
+                            return ptr::null_mut();
+                        }
+                    }
+                };
+
+                let options = DynamicGroupOptions {
+                    every,
+                    period,
+                    offset,
+                    ..Default::default()
+                };
+
+                let lazy_group_by = df
+                    .clone()
+                    .lazy()
+                    .group_by_dynamic(col(index_column), by, options);
+
+                groupby_to_c_groupby(lazy_group_by)
+            }
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(format!("Group by dynamic error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn rolling(
+    df_ptr: *mut CDataFrame,
+    index_column_ptr: *const c_char,
+    period_ptr: *const c_char,
+    by_ptr: *const *const c_char,
+    by_len: c_int,
+) -> *mut CGroupBy {
+    unsafe {
+        match c_df_to_polars_df(df_ptr) {
+            Ok(rc_df) => {
+                let df = rc_df.borrow();
+                let (index_column, period_str) = match (
+                    CStr::from_ptr(index_column_ptr).to_str(),
+                    CStr::from_ptr(period_ptr).to_str(),
+                ) {
+                    (Ok(i), Ok(p)) => (i, p),
+                    _ => {
+                        *LAST_ERROR.lock().unwrap() =
+                            Some("Invalid UTF-8 in rolling arguments".to_string());
+                        return ptr::null_mut();
+                    }
+                };
+                let by: Vec<Expr> = match c_strings_to_vec(by_ptr, by_len) {
+                    Ok(names) => names.into_iter().map(col).collect(),
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid by columns: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                let period = match Duration::try_parse(period_str) {
+                    Ok(d) => d,
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid period: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                // Each window ends at the current row: (t - period, t].
+                let offset = match Duration::try_parse(&format!("-{}", period_str)) {
+                    Ok(d) => d,
+                    Err(e) => {
+                        *LAST_ERROR.lock().unwrap() = Some(format!("Invalid period: {}", e));
+                        return ptr::null_mut();
+                    }
+                };
+
+                let options = RollingGroupOptions {
+                    period,
+                    offset,
+                    ..Default::default()
+                };
+
+                let lazy_group_by = df.clone().lazy().rolling(col(index_column), by, options);
+
+                groupby_to_c_groupby(lazy_group_by)
+            }
+            Err(e) => {
+                *LAST_ERROR.lock().unwrap() = Some(format!("Rolling error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
@@ -1,7 +1,11 @@
+mod categorical_functions;
 mod conversions;
 mod dataframe_functions;
 mod expr_functions;
 mod groupby_functions;
+mod memory;
+mod series_functions;
+mod stats_functions;
 
 use std::ffi::{c_char, CString};
 use std::ptr;
@@ -24,7 +28,11 @@ fn get_last_error_message() -> *const c_char {
     }
 }
 
+pub use categorical_functions::*;
 pub use conversions::*;
 pub use dataframe_functions::*;
 pub use expr_functions::*;
 pub use groupby_functions::*;
+pub use memory::*;
+pub use series_functions::*;
+pub use stats_functions::*;
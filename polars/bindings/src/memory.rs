@@ -0,0 +1,85 @@
+use crate::conversions::{LIVE_DATAFRAMES, LIVE_EXPRS, LIVE_GROUPBYS, LIVE_SERIES};
+use std::alloc::{GlobalAlloc, Layout, System};
+use std::ffi::{c_char, CString};
+use std::sync::atomic::{AtomicUsize, Ordering};
+
+// Every allocation made by polars goes through this allocator so the Go side
+// can see how much memory is held on the Rust heap. Strings handed to Go must
+// therefore come back through free_c_string to be subtracted.
+struct CountingAllocator;
+
+static ALLOCATED_BYTES: AtomicUsize = AtomicUsize::new(0);
+
+unsafe impl GlobalAlloc for CountingAllocator {
+    unsafe fn alloc(&self, layout: Layout) -> *mut u8 {
+        let ptr = System.alloc(layout);
+        if !ptr.is_null() {
+            ALLOCATED_BYTES.fetch_add(layout.size(), Ordering::Relaxed);
+        }
+        ptr
+    }
+
+    unsafe fn alloc_zeroed(&self, layout: Layout) -> *mut u8 {
+        let ptr = System.alloc_zeroed(layout);
+        if !ptr.is_null() {
+            ALLOCATED_BYTES.fetch_add(layout.size(), Ordering::Relaxed);
+        }
+        ptr
+    }
+
+    unsafe fn dealloc(&self, ptr: *mut u8, layout: Layout) {
+        System.dealloc(ptr, layout);
+        ALLOCATED_BYTES.fetch_sub(layout.size(), Ordering::Relaxed);
+    }
+
+    unsafe fn realloc(&self, ptr: *mut u8, layout: Layout, new_size: usize) -> *mut u8 {
+        let new_ptr = System.realloc(ptr, layout, new_size);
+        if !new_ptr.is_null() {
+            if new_size > layout.size() {
+                ALLOCATED_BYTES.fetch_add(new_size - layout.size(), Ordering::Relaxed);
+            } else {
+                ALLOCATED_BYTES.fetch_sub(layout.size() - new_size, Ordering::Relaxed);
+            }
+        }
+        new_ptr
+    }
+}
+
+#[global_allocator]
+static GLOBAL: CountingAllocator = CountingAllocator;
+
+// Releases a string created by CString::into_raw and handed to Go.
+#[no_mangle]
+pub extern "C" fn free_c_string(s: *mut c_char) {
+    if s.is_null() {
+        return;
+    }
+    unsafe {
+        drop(CString::from_raw(s));
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn allocated_bytes() -> usize {
+    ALLOCATED_BYTES.load(Ordering::Relaxed)
+}
+
+#[repr(C)]
+pub struct CMemoryStats {
+    pub dataframes: usize,
+    pub exprs: usize,
+    pub groupbys: usize,
+    pub series: usize,
+    pub allocated_bytes: usize,
+}
+
+#[no_mangle]
+pub extern "C" fn memory_stats() -> CMemoryStats {
+    CMemoryStats {
+        dataframes: LIVE_DATAFRAMES.load(Ordering::Relaxed),
+        exprs: LIVE_EXPRS.load(Ordering::Relaxed),
+        groupbys: LIVE_GROUPBYS.load(Ordering::Relaxed),
+        series: LIVE_SERIES.load(Ordering::Relaxed),
+        allocated_bytes: ALLOCATED_BYTES.load(Ordering::Relaxed),
+    }
+}
@@ -0,0 +1,345 @@
+use crate::conversions::*;
+use crate::dataframe_functions::{column_spec_to_series, CColumnSpec};
+use crate::set_last_error;
+use polars::prelude::*;
+use std::ffi::{c_int, CStr, CString};
+use std::os::raw::c_char;
+use std::ptr;
+use std::sync::atomic::Ordering;
+
+#[no_mangle]
+pub extern "C" fn create_series(spec: *const CColumnSpec) -> *mut CSeries {
+    unsafe {
+        if spec.is_null() {
+            set_last_error("Invalid parameters for Series creation");
+            return ptr::null_mut();
+        }
+
+        match column_spec_to_series(&*spec) {
+            Ok(series) => series_to_c_series(series),
+            Err(e) => {
+                set_last_error(&e);
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn free_series(series: *mut CSeries) {
+    unsafe {
+        if series.is_null() {
+            return;
+        }
+        let c_series = Box::from_raw(series);
+        if !c_series.inner.is_null() {
+            drop(Box::from_raw(c_series.inner as *mut Series));
+            drop(c_series);
+            LIVE_SERIES.fetch_sub(1, Ordering::Relaxed);
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_name(series: *const CSeries) -> *const c_char {
+    unsafe {
+        match c_series_to_series(series) {
+            Ok(s) => CString::new(s.name().as_str()).unwrap().into_raw(),
+            Err(e) => {
+                set_last_error(&format!("Series name error: {}", e));
+                ptr::null()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_len(series: *const CSeries) -> usize {
+    unsafe {
+        match c_series_to_series(series) {
+            Ok(s) => s.len(),
+            Err(_) => 0,
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_dtype(series: *const CSeries) -> CDataType {
+    unsafe {
+        match c_series_to_series(series) {
+            Ok(s) => dtype_to_c_dtype(s.dtype()),
+            Err(_) => CDataType::new(CDataTypeId::Unknown),
+        }
+    }
+}
+
+// Writes the value at index into out. Returns 0 on success and -1 on error.
+#[no_mangle]
+pub extern "C" fn series_get(series: *const CSeries, index: usize, out: *mut CValue) -> c_int {
+    unsafe {
+        if out.is_null() {
+            set_last_error("Output value pointer is null");
+            return -1;
+        }
+        let s = match c_series_to_series(series) {
+            Ok(s) => s,
+            Err(e) => {
+                set_last_error(&format!("Series get error: {}", e));
+                return -1;
+            }
+        };
+        match s.get(index) {
+            Ok(value) => {
+                ptr::write(out, any_value_to_c_value(&value));
+                0
+            }
+            Err(e) => {
+                set_last_error(&format!("Series get error: {}", e));
+                -1
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_slice(series: *const CSeries, offset: i64, length: usize) -> *mut CSeries {
+    unsafe {
+        match c_series_to_series(series) {
+            Ok(s) => series_to_c_series(s.slice(offset, length)),
+            Err(e) => {
+                set_last_error(&format!("Series slice error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Arithmetic operations
+unsafe fn series_binary_op(
+    left: *const CSeries,
+    right: *const CSeries,
+    op: fn(&Series, &Series) -> PolarsResult<Series>,
+) -> *mut CSeries {
+    match (c_series_to_series(left), c_series_to_series(right)) {
+        (Ok(a), Ok(b)) => match op(&a, &b) {
+            Ok(result) => series_to_c_series(result),
+            Err(e) => {
+                set_last_error(&format!("Series arithmetic error: {}", e));
+                ptr::null_mut()
+            }
+        },
+        _ => {
+            set_last_error("Error converting Series");
+            ptr::null_mut()
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_add(left: *const CSeries, right: *const CSeries) -> *mut CSeries {
+    unsafe { series_binary_op(left, right, |a, b| a + b) }
+}
+
+#[no_mangle]
+pub extern "C" fn series_sub(left: *const CSeries, right: *const CSeries) -> *mut CSeries {
+    unsafe { series_binary_op(left, right, |a, b| a - b) }
+}
+
+#[no_mangle]
+pub extern "C" fn series_mul(left: *const CSeries, right: *const CSeries) -> *mut CSeries {
+    unsafe { series_binary_op(left, right, |a, b| a * b) }
+}
+
+#[no_mangle]
+pub extern "C" fn series_div(left: *const CSeries, right: *const CSeries) -> *mut CSeries {
+    unsafe { series_binary_op(left, right, |a, b| a / b) }
+}
+
+// Aggregations. Returns 0 on success and -1 on error.
+#[no_mangle]
+pub extern "C" fn series_sum(series: *const CSeries, out: *mut CValue) -> c_int {
+    unsafe {
+        if out.is_null() {
+            set_last_error("Output value pointer is null");
+            return -1;
+        }
+        // Keeps the type polars gives the sum, so large integers and decimals
+        // are not rounded through f64.
+        let result = c_series_to_series(series).and_then(|s| s.sum_reduce().map_err(|e| e.to_string()));
+        match result {
+            Ok(sum) => {
+                ptr::write(out, any_value_to_c_value(sum.value()));
+                0
+            }
+            Err(e) => {
+                set_last_error(&format!("Series sum error: {}", e));
+                -1
+            }
+        }
+    }
+}
+
+// Returns NaN when the mean is undefined, e.g. for empty or non-numeric Series.
+#[no_mangle]
+pub extern "C" fn series_mean(series: *const CSeries) -> f64 {
+    unsafe {
+        match c_series_to_series(series) {
+            Ok(s) => s.mean().unwrap_or(f64::NAN),
+            Err(_) => f64::NAN,
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_unique(series: *const CSeries) -> *mut CSeries {
+    unsafe {
+        match c_series_to_series(series).and_then(|s| s.unique_stable().map_err(|e| e.to_string())) {
+            Ok(unique) => series_to_c_series(unique),
+            Err(e) => {
+                set_last_error(&format!("Series unique error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Counts occurrences of each value, most frequent first, in a "count" column.
+#[no_mangle]
+pub extern "C" fn series_value_counts(series: *const CSeries) -> *mut CDataFrame {
+    unsafe {
+        let result = c_series_to_series(series).and_then(|s| {
+            s.value_counts(true, false, "count".into(), false)
+                .map_err(|e| e.to_string())
+        });
+        match result {
+            Ok(df) => polars_df_to_c_df(df),
+            Err(e) => {
+                set_last_error(&format!("Series value counts error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_sort(series: *const CSeries, descending: u8) -> *mut CSeries {
+    unsafe {
+        let options = SortOptions::default().with_order_descending(descending != 0);
+        match c_series_to_series(series).and_then(|s| s.sort(options).map_err(|e| e.to_string())) {
+            Ok(sorted) => series_to_c_series(sorted),
+            Err(e) => {
+                set_last_error(&format!("Series sort error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn series_cast(series: *const CSeries, dtype: CDataType) -> *mut CSeries {
+    unsafe {
+        let result = c_dtype_to_dtype(&dtype).and_then(|dtype| {
+            c_series_to_series(series).and_then(|s| s.strict_cast(&dtype).map_err(|e| e.to_string()))
+        });
+        match result {
+            Ok(cast) => series_to_c_series(cast),
+            Err(e) => {
+                set_last_error(&format!("Series cast error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// DataFrame conversions
+
+#[no_mangle]
+pub extern "C" fn dataframe_column(df: *const CDataFrame, name: *const c_char) -> *mut CSeries {
+    unsafe {
+        let name_str = match CStr::from_ptr(name).to_str() {
+            Ok(s) => s,
+            Err(_) => {
+                set_last_error("Invalid UTF-8 column name");
+                return ptr::null_mut();
+            }
+        };
+        match c_df_to_polars_df_ref(df) {
+            Ok(rc_df) => match rc_df.borrow().column(name_str) {
+                Ok(column) => series_to_c_series(column.as_materialized_series().clone()),
+                Err(e) => {
+                    set_last_error(&format!("Column error: {}", e));
+                    ptr::null_mut()
+                }
+            },
+            Err(e) => {
+                set_last_error(&format!("Column error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Adds the Series as a column, replacing any existing column with the same name.
+#[no_mangle]
+pub extern "C" fn dataframe_with_series(
+    df: *const CDataFrame,
+    series: *const CSeries,
+) -> *mut CDataFrame {
+    unsafe {
+        match (c_df_to_polars_df_ref(df), c_series_to_series(series)) {
+            (Ok(rc_df), Ok(s)) => {
+                let mut new_df = rc_df.borrow().clone();
+                match new_df.with_column(s) {
+                    Ok(_) => polars_df_to_c_df(new_df),
+                    Err(e) => {
+                        set_last_error(&format!("With series error: {}", e));
+                        ptr::null_mut()
+                    }
+                }
+            }
+            _ => {
+                set_last_error("Error converting DataFrame or Series");
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+#[no_mangle]
+pub extern "C" fn dataframe_hstack(
+    df: *const CDataFrame,
+    series: *const *const CSeries,
+    series_len: c_int,
+) -> *mut CDataFrame {
+    unsafe {
+        if series.is_null() && series_len > 0 {
+            set_last_error("Series array is null");
+            return ptr::null_mut();
+        }
+
+        let mut columns: Vec<Column> = Vec::with_capacity(series_len.max(0) as usize);
+        for i in 0..series_len.max(0) as usize {
+            match c_series_to_series(*series.add(i)) {
+                Ok(s) => columns.push(s.into_column()),
+                Err(e) => {
+                    set_last_error(&format!("Error converting Series: {}", e));
+                    return ptr::null_mut();
+                }
+            }
+        }
+
+        match c_df_to_polars_df_ref(df) {
+            Ok(rc_df) => match rc_df.borrow().hstack(&columns) {
+                Ok(new_df) => polars_df_to_c_df(new_df),
+                Err(e) => {
+                    set_last_error(&format!("HStack error: {}", e));
+                    ptr::null_mut()
+                }
+            },
+            Err(e) => {
+                set_last_error(&format!("HStack error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
@@ -0,0 +1,233 @@
+use crate::conversions::*;
+use crate::set_last_error;
+use polars::prelude::*;
+use std::ptr;
+
+// Correlation method enum
+#[repr(C)]
+pub enum CCorrMethod {
+    Pearson = 0,
+    Spearman = 1,
+}
+
+#[no_mangle]
+pub extern "C" fn expr_corr(a_ptr: *mut CExpr, b_ptr: *mut CExpr, method: CCorrMethod) -> *mut CExpr {
+    unsafe {
+        let a_result = c_expr_to_expr(a_ptr);
+        let b_result = c_expr_to_expr(b_ptr);
+        match (a_result, b_result) {
+            (Ok(a), Ok(b)) => match method {
+                CCorrMethod::Pearson => expr_to_c_expr(pearson_corr(a, b)),
+                CCorrMethod::Spearman => expr_to_c_expr(spearman_rank_corr(a, b, false)),
+            },
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+// Sample covariance, with one delta degree of freedom.
+#[no_mangle]
+pub extern "C" fn expr_cov(a_ptr: *mut CExpr, b_ptr: *mut CExpr) -> *mut CExpr {
+    unsafe {
+        let a_result = c_expr_to_expr(a_ptr);
+        let b_result = c_expr_to_expr(b_ptr);
+        match (a_result, b_result) {
+            (Ok(a), Ok(b)) => expr_to_c_expr(cov(a, b, 1)),
+            _ => ptr::null_mut(),
+        }
+    }
+}
+
+// Summary statistics of one column. Numeric columns get every statistic;
+// other columns only get counts, min and max.
+struct ColumnSummary {
+    count: usize,
+    null_count: usize,
+    mean: Option<f64>,
+    std: Option<f64>,
+    min: Option<AnyValue<'static>>,
+    quantiles: Vec<Option<f64>>,
+    max: Option<AnyValue<'static>>,
+}
+
+fn summarize(s: &Series, percentiles: &[f64]) -> PolarsResult<ColumnSummary> {
+    let null_count = s.null_count();
+    let mut summary = ColumnSummary {
+        count: s.len() - null_count,
+        null_count,
+        mean: None,
+        std: None,
+        min: None,
+        quantiles: vec![None; percentiles.len()],
+        max: None,
+    };
+
+    if s.dtype().is_numeric() || s.dtype().is_bool() {
+        let values = s.cast(&DataType::Float64)?;
+        let ca = values.f64()?;
+        summary.mean = ca.mean();
+        summary.std = ca.std(1);
+        summary.min = ca.min().map(AnyValue::Float64);
+        summary.max = ca.max().map(AnyValue::Float64);
+        for (i, &q) in percentiles.iter().enumerate() {
+            summary.quantiles[i] = ca.quantile(q, QuantileMethod::Nearest)?;
+        }
+    } else if !s.dtype().is_nested() {
+        summary.min = Some(s.min_reduce()?.into_value().into_static());
+        summary.max = Some(s.max_reduce()?.into_value().into_static());
+    }
+    Ok(summary)
+}
+
+// Describes every column with count, null_count, mean, std, min, the given
+// percentiles and max. The first column names the statistic of each row. It
+// is called "statistic", with underscores prepended while that name is taken
+// by an input column.
+#[no_mangle]
+pub extern "C" fn dataframe_describe(
+    df_ptr: *const CDataFrame,
+    percentiles: *const f64,
+    percentiles_len: usize,
+) -> *mut CDataFrame {
+    unsafe {
+        if percentiles.is_null() && percentiles_len > 0 {
+            set_last_error("Percentile array is null");
+            return ptr::null_mut();
+        }
+        let percentiles: &[f64] = if percentiles_len == 0 {
+            &[]
+        } else {
+            std::slice::from_raw_parts(percentiles, percentiles_len)
+        };
+        if let Some(p) = percentiles.iter().find(|p| !(0.0..=1.0).contains(*p)) {
+            set_last_error(&format!("Percentile {} is not between 0 and 1", p));
+            return ptr::null_mut();
+        }
+
+        let mut statistics: Vec<String> = ["count", "null_count", "mean", "std", "min"]
+            .iter()
+            .map(|s| s.to_string())
+            .collect();
+        statistics.extend(percentiles.iter().map(|p| percentile_label(*p)));
+        statistics.push("max".to_string());
+
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            let df = rc_df.borrow();
+            let mut label = "statistic".to_string();
+            while df.get_column_index(&label).is_some() {
+                label.insert(0, '_');
+            }
+            let mut columns = vec![Column::new(label.into(), statistics)];
+            for column in df.get_columns() {
+                let s = column.as_materialized_series();
+                let summary = summarize(s, percentiles).map_err(|e| e.to_string())?;
+                let numeric = s.dtype().is_numeric() || s.dtype().is_bool();
+                columns.push(summary_column(s.name().clone(), &summary, numeric));
+            }
+            DataFrame::new(columns).map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(described) => polars_df_to_c_df(described),
+            Err(e) => {
+                set_last_error(&format!("Describe error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
+
+// Formats a percentile as a label such as "25%", rounded to six decimals so
+// floating point noise like 7.000000000000001 does not show up.
+fn percentile_label(p: f64) -> String {
+    let label = format!("{:.6}", p * 100.0);
+    format!("{}%", label.trim_end_matches('0').trim_end_matches('.'))
+}
+
+// Numeric columns are described as Float64 values and others as strings.
+fn summary_column(name: PlSmallStr, summary: &ColumnSummary, numeric: bool) -> Column {
+    if numeric {
+        let as_f64 = |v: &Option<AnyValue>| v.as_ref().and_then(|v| v.extract::<f64>());
+        let mut values = vec![
+            Some(summary.count as f64),
+            Some(summary.null_count as f64),
+            summary.mean,
+            summary.std,
+            as_f64(&summary.min),
+        ];
+        values.extend(summary.quantiles.iter().copied());
+        values.push(as_f64(&summary.max));
+        Column::new(name, values)
+    } else {
+        let as_string = |v: &Option<AnyValue>| match v {
+            Some(AnyValue::Null) | None => None,
+            Some(v) => Some(v.str_value().to_string()),
+        };
+        let mut values = vec![
+            Some(summary.count.to_string()),
+            Some(summary.null_count.to_string()),
+            None,
+            None,
+            as_string(&summary.min),
+        ];
+        values.extend(summary.quantiles.iter().map(|_| None));
+        values.push(as_string(&summary.max));
+        Column::new(name, values)
+    }
+}
+
+// Returns the Pearson correlation matrix of the numeric columns: one column
+// per numeric column, with rows in the same order.
+#[no_mangle]
+pub extern "C" fn dataframe_corr(df_ptr: *const CDataFrame) -> *mut CDataFrame {
+    unsafe {
+        let result = c_df_to_polars_df_ref(df_ptr).and_then(|rc_df| {
+            let df = rc_df.borrow();
+            let names: Vec<PlSmallStr> = df
+                .get_columns()
+                .iter()
+                .filter(|c| c.dtype().is_numeric())
+                .map(|c| c.name().clone())
+                .collect();
+            if names.is_empty() {
+                return Err("DataFrame has no numeric columns".to_string());
+            }
+
+            let mut exprs = Vec::with_capacity(names.len() * names.len());
+            for (i, a) in names.iter().enumerate() {
+                for b in names.iter() {
+                    let a_expr = col(a.clone()).cast(DataType::Float64);
+                    let b_expr = col(b.clone()).cast(DataType::Float64);
+                    exprs.push(pearson_corr(a_expr, b_expr).alias(format!("{}_{}", i, b)));
+                }
+            }
+            let pairs = df
+                .clone()
+                .lazy()
+                .select(exprs)
+                .collect()
+                .map_err(|e| e.to_string())?;
+
+            let mut columns = Vec::with_capacity(names.len());
+            for (j, name) in names.iter().enumerate() {
+                let mut values = Vec::with_capacity(names.len());
+                for i in 0..names.len() {
+                    let value = pairs.get_columns()[i * names.len() + j]
+                        .get(0)
+                        .map_err(|e| e.to_string())?;
+                    values.push(value.extract::<f64>());
+                }
+                columns.push(Column::new(name.clone(), values));
+            }
+            DataFrame::new(columns).map_err(|e| e.to_string())
+        });
+
+        match result {
+            Ok(matrix) => polars_df_to_c_df(matrix),
+            Err(e) => {
+                set_last_error(&format!("Correlation error: {}", e));
+                ptr::null_mut()
+            }
+        }
+    }
+}
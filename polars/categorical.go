@@ -0,0 +1,88 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"runtime"
+	"strings"
+)
+
+// Enum returns the type of strings restricted to the given categories, in
+// that order. Casting a value outside the categories to an Enum fails.
+func Enum(categories ...string) DataType {
+	return DataType{id: C.DTYPE_ENUM, categories: strings.Join(categories, "\x00")}
+}
+
+// Categories returns the categories of an Enum data type, or nil for other
+// data types.
+func (dt DataType) Categories() []string {
+	if dt.id != C.DTYPE_ENUM || dt.categories == "" {
+		return nil
+	}
+	return strings.Split(dt.categories, "\x00")
+}
+
+// EnableStringCache enables the global string cache. Categorical columns
+// created while it is enabled share their encoding, which is required to
+// join, compare or concatenate categoricals built separately. The cache stays
+// enabled until DisableStringCache is called.
+func EnableStringCache() {
+	C.string_cache_enable()
+}
+
+// DisableStringCache disables the global string cache.
+func DisableStringCache() {
+	C.string_cache_disable()
+}
+
+// StringCacheEnabled reports whether the global string cache is enabled.
+func StringCacheEnabled() bool {
+	return C.string_cache_enabled() != 0
+}
+
+// CategoricalNamespace groups the expressions operating on Categorical and
+// Enum values.
+type CategoricalNamespace struct {
+	expr Expr
+}
+
+// Cat returns the categorical namespace of the expression.
+func (e Expr) Cat() CategoricalNamespace {
+	return CategoricalNamespace{expr: e}
+}
+
+// GetCategories creates an expression with the categories of the column.
+func (c CategoricalNamespace) GetCategories() Expr {
+	defer runtime.KeepAlive(c.expr)
+
+	return newExpr(C.expr_cat_get_categories(c.expr.cptr()))
+}
+
+// GetCategories returns the categories of a Categorical or Enum Series, or of
+// a List of them, as a String Series.
+func (s *Series) GetCategories() *Series {
+	defer runtime.KeepAlive(s)
+
+	return wrapSeries(C.series_get_categories(s.ptr), "getting categories")
+}
+
+// categories returns the categories of a Categorical or Enum Series.
+func (s *Series) categories() []string {
+	categories := s.GetCategories()
+	defer categories.Free()
+
+	values := make([]string, 0, categories.Len())
+	for i := 0; i < categories.Len(); i++ {
+		if v, err := categories.Get(i); err == nil {
+			if str, ok := v.(string); ok {
+				values = append(values, str)
+			}
+		}
+	}
+	return values
+}
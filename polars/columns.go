@@ -0,0 +1,115 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"log"
+	"math"
+	"runtime"
+	"unsafe"
+)
+
+// Drop returns the DataFrame without the given columns. It fails if a
+// column does not exist.
+func (df *DataFrame) Drop(columns ...string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	return wrapDataFrame(C.dataframe_drop(df.ptr, cColumns, C.int(len(columns))), "dropping columns")
+}
+
+// Rename returns the DataFrame with columns renamed from each key of mapping
+// to its value. Renames are applied together, so two columns can swap names.
+func (df *DataFrame) Rename(mapping map[string]string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	existing := make([]string, 0, len(mapping))
+	newNames := make([]string, 0, len(mapping))
+	for old, name := range mapping {
+		existing = append(existing, old)
+		newNames = append(newNames, name)
+	}
+
+	cExisting, freeExisting := cStringArray(existing)
+	defer freeExisting()
+
+	cNewNames, freeNewNames := cStringArray(newNames)
+	defer freeNewNames()
+
+	return wrapDataFrame(C.dataframe_rename(df.ptr, cExisting, cNewNames, C.int(len(existing))), "renaming columns")
+}
+
+// WithRowIndex inserts a UInt32 column called name numbering the rows from
+// offset, as the first column.
+func (df *DataFrame) WithRowIndex(name string, offset int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if offset < 0 || offset > math.MaxUint32 {
+		log.Printf("error: row index offset %d out of range", offset)
+		return &DataFrame{}
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return wrapDataFrame(C.dataframe_with_row_index(df.ptr, cName, C.uint32_t(offset)), "adding row index")
+}
+
+// Reorder moves the given columns to the front in that order, followed by
+// the remaining columns in their original order.
+func (df *DataFrame) Reorder(columns ...string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	return wrapDataFrame(C.dataframe_reorder(df.ptr, cColumns, C.int(len(columns))), "reordering columns")
+}
+
+// All creates an expression selecting every column.
+func All() Expr {
+	return newExpr(C.expr_all())
+}
+
+// Exclude creates an expression selecting every column except the given
+// ones. It is a shorthand for All().Exclude(columns...).
+func Exclude(columns ...string) Expr {
+	return All().Exclude(columns...)
+}
+
+// Exclude removes the given columns from a multi-column expression such as
+// All or a regular expression Col.
+func (e Expr) Exclude(columns ...string) Expr {
+	defer runtime.KeepAlive(e)
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	return newExpr(C.expr_exclude(e.cptr(), cColumns, C.int(len(columns))))
+}
@@ -0,0 +1,105 @@
+// Package cs provides column selectors: expressions that select columns by
+// type or name pattern. They can be used anywhere Select, WithColumns or
+// GroupBy.Agg take expressions, and combined with Union, Intersection and
+// Difference.
+//
+//	df.Select(cs.Difference(cs.Numeric(), cs.StartsWith("id_")))
+package cs
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+// All selects every column.
+func All() polars.Expr {
+	return polars.All()
+}
+
+// ByDtype selects the columns of any of the given types.
+func ByDtype(dtypes ...polars.DataType) polars.Expr {
+	return polars.DtypeCols(dtypes...)
+}
+
+// Numeric selects the integer and floating point columns. Decimal columns are
+// not included, since a data type selector matches a decimal's precision and
+// scale exactly; select them with ByDtype(polars.Decimal(precision, scale)).
+func Numeric() polars.Expr {
+	return polars.DtypeCols(
+		polars.Int8, polars.Int16, polars.Int32, polars.Int64,
+		polars.UInt8, polars.UInt16, polars.UInt32, polars.UInt64,
+		polars.Float32, polars.Float64,
+	)
+}
+
+// StringCols selects the String columns.
+func StringCols() polars.Expr {
+	return polars.DtypeCols(polars.String)
+}
+
+// ByName selects the named columns, in the DataFrame's column order.
+func ByName(names ...string) polars.Expr {
+	if len(names) == 0 {
+		return polars.Expr{}
+	}
+	return polars.Col("^(?:" + quoteAll(names) + ")$")
+}
+
+// StartsWith selects the columns whose name starts with any of the prefixes.
+func StartsWith(prefixes ...string) polars.Expr {
+	if len(prefixes) == 0 {
+		return polars.Expr{}
+	}
+	return polars.Col("^(?:" + quoteAll(prefixes) + ").*$")
+}
+
+// EndsWith selects the columns whose name ends with any of the suffixes.
+func EndsWith(suffixes ...string) polars.Expr {
+	if len(suffixes) == 0 {
+		return polars.Expr{}
+	}
+	return polars.Col("^.*(?:" + quoteAll(suffixes) + ")$")
+}
+
+// Matches selects the columns whose name contains a match of the regular
+// expression. Anchor it with ^ or $ to match the start or end of the name.
+func Matches(pattern string) polars.Expr {
+	return polars.Col("^.*(?:" + pattern + ").*$")
+}
+
+// Union selects the columns selected by any of the selectors.
+func Union(selectors ...polars.Expr) polars.Expr {
+	return fold(selectors, polars.Expr.Union)
+}
+
+// Intersection selects the columns selected by all of the selectors.
+func Intersection(selectors ...polars.Expr) polars.Expr {
+	return fold(selectors, polars.Expr.Intersection)
+}
+
+// Difference selects the columns selected by selector but none of the
+// others.
+func Difference(selector polars.Expr, others ...polars.Expr) polars.Expr {
+	return fold(append([]polars.Expr{selector}, others...), polars.Expr.Difference)
+}
+
+func fold(selectors []polars.Expr, op func(polars.Expr, polars.Expr) polars.Expr) polars.Expr {
+	if len(selectors) == 0 {
+		return polars.Expr{}
+	}
+	result := selectors[0]
+	for _, selector := range selectors[1:] {
+		result = op(result, selector)
+	}
+	return result
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = regexp.QuoteMeta(v)
+	}
+	return strings.Join(quoted, "|")
+}
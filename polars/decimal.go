@@ -0,0 +1,61 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Decimal returns the type of exact decimal numbers with up to precision
+// significant digits, scale of them after the decimal point. A precision of
+// 0 lets Polars infer it. Use it instead of Float64 for monetary values.
+func Decimal(precision, scale int) DataType {
+	return DataType{id: C.DTYPE_DECIMAL, precision: precision, scale: scale}
+}
+
+// AddDecimalColumn adds a decimal column to the DataFrame. nil values are
+// null. Build fails if a value has more than scale digits after the decimal
+// point, since it could not be stored without rounding.
+func (b *DataFrameBuilder) AddDecimalColumn(name string, values []*big.Rat, precision, scale int) *DataFrameBuilder {
+	if err := b.validateColumnLength(len(values)); err != nil {
+		return b
+	}
+
+	unit := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	for i, val := range values {
+		if val == nil {
+			continue
+		}
+		if !new(big.Rat).Mul(val, unit).IsInt() {
+			if b.err == nil {
+				b.err = fmt.Errorf("decimal column %q: value %s at row %d does not fit scale %d", name, val.RatString(), i, scale)
+			}
+			return b
+		}
+	}
+
+	b.columns = append(b.columns, columnSpec{
+		name:       name,
+		columnType: C.COLUMN_DECIMAL,
+		data:       values,
+		length:     len(values),
+		precision:  precision,
+		scale:      scale,
+	})
+	return b
+}
+
+// decimalValue rebuilds the exact value of a decimal from its 128-bit
+// mantissa and scale.
+func decimalValue(value *C.CValue) *big.Rat {
+	mantissa := new(big.Int).Lsh(big.NewInt(int64(value.int_value)), 64)
+	mantissa.Add(mantissa, new(big.Int).SetUint64(uint64(value.uint_value)))
+
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(value.scale)), nil)
+	return new(big.Rat).SetFrac(mantissa, denominator)
+}
@@ -3,6 +3,7 @@ package polars
 import (
 	"errors"
 	"fmt"
+	"runtime"
 	"unsafe"
 )
 
@@ -16,17 +17,72 @@ import (
 */
 import "C"
 
+// CSVOptions configures ReadCSV.
+type CSVOptions struct {
+	// SchemaOverrides sets the type of the named columns instead of the
+	// inferred one, for example Decimal(18, 2) for monetary values that would
+	// otherwise be read as Float64.
+	SchemaOverrides map[string]DataType
+	// InferDecimals reads the columns that would be inferred as Float64 as
+	// Decimal(38, scale) when every value is a plain decimal number such as
+	// 12.50, scale being the largest number of fractional digits. Columns
+	// with values like 1e-3 or NaN stay Float64. The file is read again for
+	// every such column found, so it is off by default.
+	InferDecimals bool
+}
+
 // ReadCSV reads a CSV file into a DataFrame.
-func ReadCSV(filePath string) (*DataFrame, error) {
+func ReadCSV(filePath string, opts ...CSVOptions) (*DataFrame, error) {
 	cPath := C.CString(filePath)
 	defer C.free(unsafe.Pointer(cPath))
 
-	df := C.read_csv(cPath)
+	var options CSVOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	cNames, cDtypes, cLen, freeOverrides := schemaOverrides(options.SchemaOverrides)
+	defer freeOverrides()
+
+	var cInferDecimals C.int
+	if options.InferDecimals {
+		cInferDecimals = 1
+	}
+
+	df := C.read_csv(cPath, cNames, cDtypes, cLen, cInferDecimals)
 	if df == nil || (*C.CDataFrame)(df).handle == nil {
-		return nil, errors.New(C.GoString(C.get_last_error_message()))
+		return nil, errors.New(lastError())
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(df)}, nil
+	return newDataFrame(df), nil
+}
+
+// schemaOverrides converts CSV schema overrides for Rust. The returned
+// function releases them and must be called once they have been used.
+func schemaOverrides(overrides map[string]DataType) (**C.char, *C.CDataType, C.int, func()) {
+	names := make([]string, 0, len(overrides))
+	cDtypes := make([]C.CDataType, 0, len(overrides))
+	var frees []func()
+	for name, dtype := range overrides {
+		cDtype, freeDtype := dtype.toC()
+		frees = append(frees, freeDtype)
+		names = append(names, name)
+		cDtypes = append(cDtypes, cDtype)
+	}
+
+	cNames, freeNames := cStringArray(names)
+	frees = append(frees, freeNames)
+
+	var cDtypesPtr *C.CDataType
+	if len(cDtypes) > 0 {
+		cDtypesPtr = &cDtypes[0]
+	}
+
+	return cNames, cDtypesPtr, C.int(len(names)), func() {
+		for _, free := range frees {
+			free()
+		}
+	}
 }
 
 // ReadParquet reads a Parquet file into a DataFrame.
@@ -36,14 +92,97 @@ func ReadParquet(filePath string) (*DataFrame, error) {
 
 	df := C.read_parquet(cPath)
 	if df == nil || (*C.CDataFrame)(df).handle == nil {
-		return nil, errors.New(C.GoString(C.get_last_error_message()))
+		return nil, errors.New(lastError())
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(df)}, nil
+	return newDataFrame(df), nil
+}
+
+// ScanOptions configures reading several files into one DataFrame.
+type ScanOptions struct {
+	// HivePartitioning adds columns parsed from hive-style directory names
+	// such as year=2024/month=01. It only applies to Parquet.
+	HivePartitioning bool
+	// IncludeFilePaths, if set, is the name of a column holding the path of
+	// the file each row was read from.
+	IncludeFilePaths string
+	// SchemaOverrides sets the type of the named columns instead of the
+	// inferred one, as in CSVOptions. It only applies to CSV.
+	SchemaOverrides map[string]DataType
+	// InferDecimals reads float columns as decimals, as in CSVOptions. It
+	// only applies to CSV.
+	InferDecimals bool
+}
+
+func (opts ScanOptions) toC() (C.CScanOptions, func()) {
+	var cOpts C.CScanOptions
+	if opts.HivePartitioning {
+		cOpts.hive_partitioning = 1
+	}
+	cColumn := C.CString(opts.IncludeFilePaths)
+	cOpts.include_file_paths = cColumn
+	return cOpts, func() { C.free(unsafe.Pointer(cColumn)) }
+}
+
+// ScanParquet reads every Parquet file matching a glob pattern, such as
+// "data/year=*/month=*/*.parquet", into a single DataFrame. The files must
+// share the same schema.
+func ScanParquet(pattern string, opts ...ScanOptions) (*DataFrame, error) {
+	cPattern := C.CString(pattern)
+	defer C.free(unsafe.Pointer(cPattern))
+
+	var options ScanOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	cOpts, freeOpts := options.toC()
+	defer freeOpts()
+
+	df := C.scan_parquet(cPattern, cOpts)
+	if df == nil || (*C.CDataFrame)(df).handle == nil {
+		return nil, errors.New(lastError())
+	}
+
+	return newDataFrame(df), nil
+}
+
+// ReadCSVFiles reads several CSV files with the same columns into a single
+// DataFrame, in the order given.
+func ReadCSVFiles(paths []string, opts ...ScanOptions) (*DataFrame, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no CSV files given")
+	}
+
+	cPaths, freePaths := cStringArray(paths)
+	defer freePaths()
+
+	var options ScanOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	cOpts, freeOpts := options.toC()
+	defer freeOpts()
+
+	cNames, cDtypes, cLen, freeOverrides := schemaOverrides(options.SchemaOverrides)
+	defer freeOverrides()
+
+	var cInferDecimals C.int
+	if options.InferDecimals {
+		cInferDecimals = 1
+	}
+
+	df := C.read_csv_files(cPaths, C.int(len(paths)), cOpts, cNames, cDtypes, cLen, cInferDecimals)
+	if df == nil || (*C.CDataFrame)(df).handle == nil {
+		return nil, errors.New(lastError())
+	}
+
+	return newDataFrame(df), nil
 }
 
 // WriteCSV writes the DataFrame to a CSV file.
-func (df DataFrame) WriteCSV(filePath string) error {
+func (df *DataFrame) WriteCSV(filePath string) error {
+	defer runtime.KeepAlive(df)
+
 	cFilePath := C.CString(filePath)
 	defer C.free(unsafe.Pointer(cFilePath))
 
@@ -53,7 +192,7 @@ func (df DataFrame) WriteCSV(filePath string) error {
 		return errors.New("write_csv error: unknown failure")
 	}
 
-	msg := C.GoString(res)
+	msg := goString(res)
 	if msg != "CSV written successfully" {
 		return fmt.Errorf("write_csv error: %s", msg)
 	}
@@ -61,7 +200,9 @@ func (df DataFrame) WriteCSV(filePath string) error {
 }
 
 // WriteParquet writes the DataFrame to a Parquet file.
-func (df DataFrame) WriteParquet(filePath string) error {
+func (df *DataFrame) WriteParquet(filePath string) error {
+	defer runtime.KeepAlive(df)
+
 	cFilePath := C.CString(filePath)
 	defer C.free(unsafe.Pointer(cFilePath))
 
@@ -71,9 +212,53 @@ func (df DataFrame) WriteParquet(filePath string) error {
 		return errors.New("write_parquet error: unknown failure")
 	}
 
-	msg := C.GoString(res)
+	msg := goString(res)
 	if msg != "Parquet written successfully" {
 		return fmt.Errorf("write_parquet error: %s", msg)
 	}
 	return nil
 }
+
+// PartitionOptions configures WriteParquetPartitioned.
+type PartitionOptions struct {
+	// MaxRowsPerFile splits partitions into several files of at most this many
+	// rows. Zero writes a single file per partition.
+	MaxRowsPerFile int
+	// KeepPartitionColumns also stores the partition columns inside the files.
+	// By default they are only encoded in the directory names.
+	KeepPartitionColumns bool
+}
+
+// WriteParquetPartitioned writes the DataFrame as a hive-partitioned dataset:
+// one dir/col=value/ directory per distinct combination of the partitionBy
+// columns, each holding one or more part-N.parquet files. Null keys are written
+// as __HIVE_DEFAULT_PARTITION__. It fails without writing anything if one of
+// the partition directories already holds files, which would otherwise be read
+// back as part of the dataset; remove dir first to rewrite a dataset.
+func (df *DataFrame) WriteParquetPartitioned(dir string, partitionBy []string, opts PartitionOptions) error {
+	defer runtime.KeepAlive(df)
+
+	if len(partitionBy) == 0 {
+		return errors.New("write_parquet_partitioned error: no partition columns given")
+	}
+	if opts.MaxRowsPerFile < 0 {
+		return fmt.Errorf("write_parquet_partitioned error: invalid MaxRowsPerFile %d", opts.MaxRowsPerFile)
+	}
+
+	cDir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cDir))
+
+	cPartitionBy, freePartitionBy := cStringArray(partitionBy)
+	defer freePartitionBy()
+
+	var cOpts C.CPartitionOptions
+	cOpts.max_rows_per_file = C.size_t(opts.MaxRowsPerFile)
+	if opts.KeepPartitionColumns {
+		cOpts.keep_partition_columns = 1
+	}
+
+	if C.write_parquet_partitioned(df.ptr, cDir, cPartitionBy, C.int(len(partitionBy)), cOpts) != 0 {
+		return fmt.Errorf("write_parquet_partitioned error: %s", lastError())
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"log"
+	"runtime"
+	"unsafe"
+)
+
+// ListNamespace groups the expressions operating on list values. Each
+// expression is applied to every list separately.
+type ListNamespace struct {
+	expr Expr
+}
+
+// List returns the list namespace of the expression.
+func (e Expr) List() ListNamespace {
+	return ListNamespace{expr: e}
+}
+
+// Implode creates an expression collecting all values into a single list.
+func (e Expr) Implode() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_implode(e.cptr()))
+}
+
+// Len creates an expression with the number of values in each list.
+func (l ListNamespace) Len() Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	return newExpr(C.expr_list_len(l.expr.cptr()))
+}
+
+// Get creates an expression with the value at index i of each list. Negative
+// indices count from the end, and lists too short give null.
+func (l ListNamespace) Get(i int) Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	return newExpr(C.expr_list_get(l.expr.cptr(), C.int64_t(i)))
+}
+
+// Contains creates a boolean expression that is true for lists holding item.
+// item is an Expr or a value accepted by Lit.
+func (l ListNamespace) Contains(item any) Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	itemExpr, ok := item.(Expr)
+	if !ok {
+		itemExpr = Lit(item)
+	}
+	defer runtime.KeepAlive(itemExpr)
+
+	return newExpr(C.expr_list_contains(l.expr.cptr(), itemExpr.cptr()))
+}
+
+// Join creates an expression concatenating the strings of each list,
+// separated by separator. Null values are skipped.
+func (l ListNamespace) Join(separator string) Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	cSeparator := C.CString(separator)
+	defer C.free(unsafe.Pointer(cSeparator))
+
+	return newExpr(C.expr_list_join(l.expr.cptr(), cSeparator))
+}
+
+// Sum creates an expression with the sum of each list.
+func (l ListNamespace) Sum() Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	return newExpr(C.expr_list_sum(l.expr.cptr()))
+}
+
+// Mean creates an expression with the mean of each list.
+func (l ListNamespace) Mean() Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	return newExpr(C.expr_list_mean(l.expr.cptr()))
+}
+
+// Sort creates an expression sorting the values of each list.
+func (l ListNamespace) Sort(descending bool) Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	var cDescending C.uint8_t
+	if descending {
+		cDescending = 1
+	}
+
+	return newExpr(C.expr_list_sort(l.expr.cptr(), cDescending))
+}
+
+// Unique creates an expression with the distinct values of each list, in
+// order of first appearance.
+func (l ListNamespace) Unique() Expr {
+	defer runtime.KeepAlive(l.expr)
+
+	return newExpr(C.expr_list_unique(l.expr.cptr()))
+}
+
+// Explode returns a DataFrame with one row per element of the given list
+// columns, repeating the values of the other columns. Columns exploded
+// together must hold lists of the same length in each row. Empty lists
+// become a single null.
+func (df *DataFrame) Explode(columns ...string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	explodedPtr := C.dataframe_explode(df.ptr, cColumns, C.int(len(columns)))
+	if explodedPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while exploding: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(explodedPtr)
+}
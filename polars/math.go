@@ -0,0 +1,163 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"log"
+	"runtime"
+)
+
+// Abs creates an expression with the absolute values.
+func (e Expr) Abs() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_abs(e.cptr()))
+}
+
+// Round creates an expression rounding the values to the given number of
+// decimals, with halves rounded away from zero.
+func (e Expr) Round(decimals int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if decimals < 0 {
+		log.Printf("error: negative number of decimals %d", decimals)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_round(e.cptr(), C.uint32_t(decimals)))
+}
+
+// Floor creates an expression rounding the values down.
+func (e Expr) Floor() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_floor(e.cptr()))
+}
+
+// Ceil creates an expression rounding the values up.
+func (e Expr) Ceil() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_ceil(e.cptr()))
+}
+
+// Sqrt creates an expression with the square roots of the values.
+func (e Expr) Sqrt() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_sqrt(e.cptr()))
+}
+
+// Log creates an expression with the logarithms of the values in the given
+// base. Use math.E for the natural logarithm.
+func (e Expr) Log(base float64) Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_log(e.cptr(), C.double(base)))
+}
+
+// Exp creates an expression raising e to the power of the values.
+func (e Expr) Exp() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_exp(e.cptr()))
+}
+
+// Sign creates an expression with -1, 0 or 1 depending on the sign of the
+// values.
+func (e Expr) Sign() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_sign(e.cptr()))
+}
+
+// Pow creates an expression raising the values to the power of exponent.
+func (e Expr) Pow(exponent Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(exponent)
+
+	return newExpr(C.expr_pow(e.cptr(), exponent.cptr()))
+}
+
+// Mod creates an expression with the remainder of dividing the values by
+// other.
+func (e Expr) Mod(other Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_mod(e.cptr(), other.cptr()))
+}
+
+// FloorDiv creates an expression dividing the values by other and rounding
+// down.
+func (e Expr) FloorDiv(other Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_floor_div(e.cptr(), other.cptr()))
+}
+
+// Clip creates an expression limiting the values to the range from lower to
+// upper.
+func (e Expr) Clip(lower, upper Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(lower)
+	defer runtime.KeepAlive(upper)
+
+	return newExpr(C.expr_clip(e.cptr(), lower.cptr(), upper.cptr()))
+}
+
+func (e Expr) trig(function C.CTrigFunction) Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_trig(e.cptr(), function))
+}
+
+// Sin creates an expression with the sine of the values, in radians.
+func (e Expr) Sin() Expr {
+	return e.trig(C.TRIG_SIN)
+}
+
+// Cos creates an expression with the cosine of the values, in radians.
+func (e Expr) Cos() Expr {
+	return e.trig(C.TRIG_COS)
+}
+
+// Tan creates an expression with the tangent of the values, in radians.
+func (e Expr) Tan() Expr {
+	return e.trig(C.TRIG_TAN)
+}
+
+// Arcsin creates an expression with the inverse sine of the values.
+func (e Expr) Arcsin() Expr {
+	return e.trig(C.TRIG_ARCSIN)
+}
+
+// Arccos creates an expression with the inverse cosine of the values.
+func (e Expr) Arccos() Expr {
+	return e.trig(C.TRIG_ARCCOS)
+}
+
+// Arctan creates an expression with the inverse tangent of the values.
+func (e Expr) Arctan() Expr {
+	return e.trig(C.TRIG_ARCTAN)
+}
+
+// Sinh creates an expression with the hyperbolic sine of the values.
+func (e Expr) Sinh() Expr {
+	return e.trig(C.TRIG_SINH)
+}
+
+// Cosh creates an expression with the hyperbolic cosine of the values.
+func (e Expr) Cosh() Expr {
+	return e.trig(C.TRIG_COSH)
+}
+
+// Tanh creates an expression with the hyperbolic tangent of the values.
+func (e Expr) Tanh() Expr {
+	return e.trig(C.TRIG_TANH)
+}
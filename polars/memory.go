@@ -0,0 +1,139 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// minRustGCGoal is the Rust heap size below which no extra collection is forced.
+const minRustGCGoal = 64 << 20
+
+// The Go garbage collector only sees the small Go wrappers, not the Rust
+// buffers they own, so a program holding large DataFrames may never feel
+// enough heap pressure to run the finalizers that release them. rustHeap
+// tracks the Rust heap and forces a collection whenever it doubles from the
+// smallest size observed since the previous one, mirroring GOGC=100.
+var rustHeap = struct {
+	sync.Mutex
+	low        uint64
+	collecting bool
+}{low: ^uint64(0)}
+
+// goString copies a string allocated by Rust and releases it.
+func goString(cStr *C.char) string {
+	if cStr == nil {
+		return ""
+	}
+	defer C.free_c_string(cStr)
+	return C.GoString(cStr)
+}
+
+// lastError returns the message of the last error recorded by Rust.
+func lastError() string {
+	return goString(C.get_last_error_message())
+}
+
+// RustAllocatedBytes returns the number of bytes currently allocated on the Rust heap.
+func RustAllocatedBytes() uint64 {
+	return uint64(C.allocated_bytes())
+}
+
+// notifyRustAllocation is called whenever a new Rust object is handed to Go and
+// triggers a garbage collection when the Rust heap has grown past its goal.
+func notifyRustAllocation() {
+	allocated := RustAllocatedBytes()
+
+	rustHeap.Lock()
+	defer rustHeap.Unlock()
+
+	if allocated < rustHeap.low {
+		rustHeap.low = allocated
+	}
+
+	goal := max(2*rustHeap.low, minRustGCGoal)
+	if rustHeap.collecting || allocated < goal {
+		return
+	}
+
+	rustHeap.collecting = true
+	rustHeap.low = allocated
+	go func() {
+		runtime.GC()
+
+		rustHeap.Lock()
+		rustHeap.collecting = false
+		rustHeap.Unlock()
+	}()
+}
+
+// MemoryStats reports the Rust-side objects currently owned by Go values and
+// the total number of bytes allocated on the Rust heap.
+type MemoryStats struct {
+	DataFrames     int
+	Exprs          int
+	GroupBys       int
+	Series         int
+	AllocatedBytes uint64
+}
+
+// Stats returns a snapshot of the live handle counts and Rust heap usage.
+func Stats() MemoryStats {
+	cStats := C.memory_stats()
+	return MemoryStats{
+		DataFrames:     int(cStats.dataframes),
+		Exprs:          int(cStats.exprs),
+		GroupBys:       int(cStats.groupbys),
+		Series:         int(cStats.series),
+		AllocatedBytes: uint64(cStats.allocated_bytes),
+	}
+}
+
+// TestingT is the subset of testing.TB used by AssertNoLeaks.
+type TestingT interface {
+	Helper()
+	Cleanup(func())
+	Errorf(format string, args ...any)
+}
+
+// AssertNoLeaks records the live handle counts and checks, once the test and
+// its deferred calls have finished, that every DataFrame, Expr, GroupBy and
+// Series created in between has been released. Unreachable values are
+// collected before the check, so only values that are still referenced count
+// as leaks.
+func AssertNoLeaks(t TestingT) {
+	t.Helper()
+	before := Stats()
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		after := Stats()
+		for i := 0; i < 20 && leaked(before, after); i++ {
+			runtime.GC()
+			time.Sleep(10 * time.Millisecond)
+			after = Stats()
+		}
+
+		if leaked(before, after) {
+			t.Errorf("polars: leaked handles: %d DataFrame(s), %d Expr(s), %d GroupBy(s), %d Series",
+				after.DataFrames-before.DataFrames,
+				after.Exprs-before.Exprs,
+				after.GroupBys-before.GroupBys,
+				after.Series-before.Series)
+		}
+	})
+}
+
+func leaked(before, after MemoryStats) bool {
+	return after.DataFrames > before.DataFrames ||
+		after.Exprs > before.Exprs ||
+		after.GroupBys > before.GroupBys ||
+		after.Series > before.Series
+}
@@ -14,39 +14,110 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/big"
+	"runtime"
+	"time"
 	"unsafe"
 )
 
 // DataFrame represents a Polars DataFrame.
+// Its memory is released by the garbage collector once the DataFrame is
+// unreachable; call Free to release it earlier.
 type DataFrame struct {
 	ptr *C.CDataFrame
 }
 
 // Expr represents a Polars expression.
+// Expressions are not consumed by the operations they are passed to, so the
+// same Expr can be reused across calls. Copies of an Expr share the underlying
+// expression, which is released once no copy is reachable or Free is called.
 type Expr struct {
+	handle *exprHandle
+}
+
+// exprHandle owns the Rust expression shared by all copies of an Expr.
+type exprHandle struct {
 	ptr *C.CExpr
 }
 
 // GroupBy represents a Polars GroupBy operation.
+// A GroupBy can be aggregated several times. Its memory is released by the
+// garbage collector once it is unreachable; call Free to release it earlier.
 type GroupBy struct {
 	ptr *C.CGroupBy
 }
 
+// newDataFrame wraps a Rust DataFrame so it is freed when garbage collected.
+func newDataFrame(ptr *C.CDataFrame) *DataFrame {
+	df := &DataFrame{ptr: ptr}
+	runtime.SetFinalizer(df, (*DataFrame).Free)
+	notifyRustAllocation()
+	return df
+}
+
+// newExpr wraps a Rust expression so it is freed when garbage collected.
+func newExpr(ptr *C.CExpr) Expr {
+	if ptr == nil {
+		return Expr{}
+	}
+	h := &exprHandle{ptr: ptr}
+	runtime.SetFinalizer(h, (*exprHandle).free)
+	return Expr{handle: h}
+}
+
+// newGroupBy wraps a Rust GroupBy so it is freed when garbage collected.
+func newGroupBy(ptr *C.CGroupBy) *GroupBy {
+	gb := &GroupBy{ptr: ptr}
+	runtime.SetFinalizer(gb, (*GroupBy).Free)
+	return gb
+}
+
+// cptr returns the Rust expression, or nil for a zero or freed Expr.
+func (e Expr) cptr() *C.CExpr {
+	if e.handle == nil {
+		return nil
+	}
+	return e.handle.ptr
+}
+
+func (h *exprHandle) free() {
+	if h.ptr != nil {
+		runtime.SetFinalizer(h, nil)
+		C.free_expr(h.ptr)
+		h.ptr = nil
+	}
+}
+
 func (e Expr) Alias(name string) Expr {
+	defer runtime.KeepAlive(e)
+
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 
-	aliasPtr := C.expr_alias(e.ptr, cName) // Call the Rust function
+	aliasPtr := C.expr_alias(e.cptr(), cName) // Call the Rust function
 	if aliasPtr == nil {
 		log.Printf("error aliasing expression")
-		return Expr{ptr: nil}
+		return Expr{}
 	}
 
-	return Expr{ptr: (*C.CExpr)(aliasPtr)}
+	return newExpr(aliasPtr)
+}
+
+// Cast creates an expression converting the values to dtype. Evaluation fails
+// if a value cannot be represented in the new type.
+func (e Expr) Cast(dtype DataType) Expr {
+	defer runtime.KeepAlive(e)
+
+	cDtype, freeDtype := dtype.toC()
+	defer freeDtype()
+
+	return newExpr(C.expr_cast(e.cptr(), cDtype))
 }
 
 // String returns a string representation of the DataFrame.
 func (df *DataFrame) String() string {
+	defer runtime.KeepAlive(df)
+
 	if df.ptr == nil || df.ptr.handle == nil {
 		return "<nil DataFrame>"
 	}
@@ -55,14 +126,15 @@ func (df *DataFrame) String() string {
 	if cStr == nil {
 		return "<error printing DataFrame>"
 	}
-	defer C.free(unsafe.Pointer(cStr))
 
-	return C.GoString(cStr)
+	return goString(cStr)
 }
 
 // Free releases the memory associated with the DataFrame.
+// It is safe to call Free more than once.
 func (df *DataFrame) Free() {
 	if df.ptr != nil {
+		runtime.SetFinalizer(df, nil)
 		C.free_dataframe(df.ptr)
 		df.ptr = nil
 	}
@@ -70,30 +142,45 @@ func (df *DataFrame) Free() {
 
 // Width returns the number of columns in the DataFrame.
 func (df *DataFrame) Width() int {
+	defer runtime.KeepAlive(df)
+
 	return int(C.dataframe_width(df.ptr))
 }
 
 // Height returns the number of rows in the DataFrame.
 func (df *DataFrame) Height() int {
+	defer runtime.KeepAlive(df)
+
 	return int(C.dataframe_height(df.ptr))
 }
 
+// EstimatedSize returns the estimated number of bytes used by the DataFrame.
+// Buffers shared with other DataFrames are counted in full.
+func (df *DataFrame) EstimatedSize() int {
+	defer runtime.KeepAlive(df)
+
+	return int(C.dataframe_estimated_size(df.ptr))
+}
+
 // Columns returns a list of column names in the DataFrame.
 func (df *DataFrame) Columns() []string {
+	defer runtime.KeepAlive(df)
+
 	var names []string
 	for i := 0; ; i++ {
 		cStr := C.dataframe_column_name(df.ptr, C.size_t(i))
 		if cStr == nil {
 			break
 		}
-		defer C.free(unsafe.Pointer(cStr))
-		names = append(names, C.GoString(cStr))
+		names = append(names, goString(cStr))
 	}
 	return names
 }
 
 // GroupBy creates a GroupBy operation on the specified columns.
 func (df *DataFrame) GroupBy(columns ...string) *GroupBy {
+	defer runtime.KeepAlive(df)
+
 	if df.ptr == nil {
 		log.Println("error: DataFrame is nil")
 		return &GroupBy{}
@@ -113,26 +200,92 @@ func (df *DataFrame) GroupBy(columns ...string) *GroupBy {
 
 	gbPtr := C.group_by(df.ptr, cColumns)
 	if gbPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
+		return &GroupBy{}
+	}
+
+	return newGroupBy(gbPtr)
+}
+
+// GroupByStable creates a GroupBy operation on the specified columns that
+// keeps the groups in the order they first appear in the DataFrame.
+func (df *DataFrame) GroupByStable(columns ...string) *GroupBy {
+	defer runtime.KeepAlive(df)
+
+	if df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &GroupBy{}
+	}
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	gbPtr := C.group_by_stable(df.ptr, cColumns, C.int(len(columns)))
+	if gbPtr == nil {
+		log.Printf("error: %s", errors.New(lastError()))
+		return &GroupBy{}
+	}
+
+	return newGroupBy(gbPtr)
+}
+
+// GroupByExprs creates a GroupBy operation on computed keys.
+// If maintainOrder is true, groups keep the order in which they first appear.
+func (df *DataFrame) GroupByExprs(exprs []Expr, maintainOrder bool) *GroupBy {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(exprs)
+
+	if df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &GroupBy{}
+	}
+
+	if len(exprs) == 0 {
+		log.Println("error: at least one group key expression is required")
+		return &GroupBy{}
+	}
+
+	cExprs := make([]*C.CExpr, len(exprs))
+	for i, expr := range exprs {
+		cExprs[i] = expr.cptr()
+	}
+
+	cExprsPtr := (**C.CExpr)(unsafe.Pointer(&cExprs[0]))
+	cExprsLen := C.int(len(exprs))
+
+	var cMaintainOrder C.uint8_t
+	if maintainOrder {
+		cMaintainOrder = 1
+	}
+
+	gbPtr := C.group_by_exprs(df.ptr, cExprsPtr, cExprsLen, cMaintainOrder)
+	if gbPtr == nil {
+		log.Printf("error: %s", errors.New(lastError()))
 		return &GroupBy{}
 	}
 
-	return &GroupBy{ptr: (*C.CGroupBy)(gbPtr)}
+	return newGroupBy(gbPtr)
 }
 
 // Filter filters the DataFrame based on the given expression.
 func (df *DataFrame) Filter(expr Expr) *DataFrame {
-	filteredPtr := C.filter(df.ptr, expr.ptr)
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(expr)
+
+	filteredPtr := C.filter(df.ptr, expr.cptr())
 	if filteredPtr == nil {
-		err := errors.New(C.GoString(C.get_last_error_message()))
+		err := errors.New(lastError())
 		log.Printf("Error while filtering: %s", err)
 		return &DataFrame{}
 	}
-	return &DataFrame{ptr: (*C.CDataFrame)(filteredPtr)}
+	return newDataFrame(filteredPtr)
 }
 
 // Select allows selecting specific columns from the DataFrame.
 func (df *DataFrame) Select(exprs ...Expr) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(exprs)
+
 	if df.ptr == nil {
 		log.Println("error: DataFrame is nil")
 		return &DataFrame{}
@@ -140,7 +293,7 @@ func (df *DataFrame) Select(exprs ...Expr) *DataFrame {
 
 	cExprs := make([]*C.CExpr, len(exprs))
 	for i, expr := range exprs {
-		cExprs[i] = expr.ptr
+		cExprs[i] = expr.cptr()
 	}
 
 	cExprsPtr := (**C.CExpr)(unsafe.Pointer(&cExprs[0]))
@@ -149,33 +302,38 @@ func (df *DataFrame) Select(exprs ...Expr) *DataFrame {
 	newDfPtr := C.select_columns(df.ptr, cExprsPtr, cExprsLen)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
-// Col creates a new expression representing a column.
+// Col creates a new expression representing a column. A name starting with
+// ^ and ending with $, such as "^sales_.*$", is a regular expression selecting
+// every matching column.
 func Col(name string) Expr {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
-	return Expr{ptr: (*C.CExpr)(C.col(cName))}
+	return newExpr(C.col(cName))
 }
 
 // Gt creates a "greater than" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Gt(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_gt(e.ptr, C.long(v)))}
+		return newExpr(C.col_gt(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_gt(e.ptr, C.long(v)))}
+		return newExpr(C.col_gt(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_gt(e.ptr, C.long(v)))}
+		return newExpr(C.col_gt(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_gt_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_gt_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_gt_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_gt_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -183,25 +341,31 @@ func (e Expr) Gt(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_gt(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_gt(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_gt(e.cptr(), v.cptr()))
 	default:
 		panic("Gt: unsupported value type")
 	}
 }
 
 // Lt creates a "less than" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Lt(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_lt(e.ptr, C.long(v)))}
+		return newExpr(C.col_lt(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_lt(e.ptr, C.long(v)))}
+		return newExpr(C.col_lt(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_lt(e.ptr, C.long(v)))}
+		return newExpr(C.col_lt(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_lt_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_lt_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_lt_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_lt_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -209,25 +373,31 @@ func (e Expr) Lt(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_lt(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_lt(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_lt(e.cptr(), v.cptr()))
 	default:
 		panic("Lt: unsupported value type")
 	}
 }
 
 // Eq creates an "equal to" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Eq(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_eq(e.ptr, C.long(v)))}
+		return newExpr(C.col_eq(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_eq(e.ptr, C.long(v)))}
+		return newExpr(C.col_eq(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_eq(e.ptr, C.long(v)))}
+		return newExpr(C.col_eq(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_eq_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_eq_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_eq_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_eq_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -235,25 +405,31 @@ func (e Expr) Eq(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_eq(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_eq(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_eq(e.cptr(), v.cptr()))
 	default:
 		panic("Eq: unsupported value type")
 	}
 }
 
 // Ne creates a "not equal to" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Ne(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_ne(e.ptr, C.long(v)))}
+		return newExpr(C.col_ne(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_ne(e.ptr, C.long(v)))}
+		return newExpr(C.col_ne(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_ne(e.ptr, C.long(v)))}
+		return newExpr(C.col_ne(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_ne_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_ne_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_ne_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_ne_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -261,25 +437,31 @@ func (e Expr) Ne(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_ne(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_ne(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_ne(e.cptr(), v.cptr()))
 	default:
 		panic("Ne: unsupported value type")
 	}
 }
 
 // Ge creates a "greater than or equal to" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Ge(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_ge(e.ptr, C.long(v)))}
+		return newExpr(C.col_ge(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_ge(e.ptr, C.long(v)))}
+		return newExpr(C.col_ge(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_ge(e.ptr, C.long(v)))}
+		return newExpr(C.col_ge(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_ge_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_ge_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_ge_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_ge_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -287,25 +469,31 @@ func (e Expr) Ge(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_ge(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_ge(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_ge(e.cptr(), v.cptr()))
 	default:
 		panic("Ge: unsupported value type")
 	}
 }
 
 // Le creates a "less than or equal to" expression.
+// value can be a number, a bool or another Expr.
 func (e Expr) Le(value interface{}) Expr {
+	defer runtime.KeepAlive(e)
+
 	switch v := value.(type) {
 	case int:
-		return Expr{ptr: (*C.CExpr)(C.col_le(e.ptr, C.long(v)))}
+		return newExpr(C.col_le(e.cptr(), C.long(v)))
 	case int32:
-		return Expr{ptr: (*C.CExpr)(C.col_le(e.ptr, C.long(v)))}
+		return newExpr(C.col_le(e.cptr(), C.long(v)))
 	case int64:
-		return Expr{ptr: (*C.CExpr)(C.col_le(e.ptr, C.long(v)))}
+		return newExpr(C.col_le(e.cptr(), C.long(v)))
 	case float32:
-		return Expr{ptr: (*C.CExpr)(C.col_le_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_le_f64(e.cptr(), C.double(v)))
 	case float64:
-		return Expr{ptr: (*C.CExpr)(C.col_le_f64(e.ptr, C.double(v)))}
+		return newExpr(C.col_le_f64(e.cptr(), C.double(v)))
 	case bool:
 		var intVal int64
 		if v {
@@ -313,7 +501,10 @@ func (e Expr) Le(value interface{}) Expr {
 		} else {
 			intVal = 0
 		}
-		return Expr{ptr: (*C.CExpr)(C.col_le(e.ptr, C.long(intVal)))}
+		return newExpr(C.col_le(e.cptr(), C.long(intVal)))
+	case Expr:
+		defer runtime.KeepAlive(v)
+		return newExpr(C.expr_le(e.cptr(), v.cptr()))
 	default:
 		panic("Le: unsupported value type")
 	}
@@ -321,77 +512,110 @@ func (e Expr) Le(value interface{}) Expr {
 
 // Add creates an addition expression between two expressions.
 func (e Expr) Add(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_add(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_add(e.cptr(), other.cptr()))
 }
 
 // Sub creates a subtraction expression between two expressions.
 func (e Expr) Sub(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_sub(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_sub(e.cptr(), other.cptr()))
 }
 
 // Mul creates a multiplication expression between two expressions.
 func (e Expr) Mul(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_mul(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_mul(e.cptr(), other.cptr()))
 }
 
 // Div creates a division expression between two expressions.
 func (e Expr) Div(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_div(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_div(e.cptr(), other.cptr()))
 }
 
 // AddValue creates an addition expression with a numeric value.
 func (e Expr) AddValue(value float64) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_add_value(e.ptr, C.double(value)))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_add_value(e.cptr(), C.double(value)))
 }
 
 // SubValue creates a subtraction expression with a numeric value.
 func (e Expr) SubValue(value float64) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_sub_value(e.ptr, C.double(value)))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_sub_value(e.cptr(), C.double(value)))
 }
 
 // MulValue creates a multiplication expression with a numeric value.
 func (e Expr) MulValue(value float64) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_mul_value(e.ptr, C.double(value)))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_mul_value(e.cptr(), C.double(value)))
 }
 
 // DivValue creates a division expression with a numeric value.
 func (e Expr) DivValue(value float64) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_div_value(e.ptr, C.double(value)))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_div_value(e.cptr(), C.double(value)))
 }
 
 // And creates a logical AND expression between two expressions.
 func (e Expr) And(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_and(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_and(e.cptr(), other.cptr()))
 }
 
 // Or creates a logical OR expression between two expressions.
 func (e Expr) Or(other Expr) Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_or(e.ptr, other.ptr))}
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.expr_or(e.cptr(), other.cptr()))
 }
 
 // Not creates a logical NOT expression.
 func (e Expr) Not() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_not(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_not(e.cptr()))
 }
 
 // Head returns the first n rows of the DataFrame.
-func (df DataFrame) Head(n int) *DataFrame {
+func (df *DataFrame) Head(n int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
 	cHeadDf := C.head(df.ptr, C.size_t(n))
 
 	if cHeadDf == nil || (*C.CDataFrame)(cHeadDf).handle == nil {
-		err := C.GoString(C.get_last_error_message())
+		err := lastError()
 		log.Printf("Error getting head: %s", err)
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(cHeadDf)}
+	return newDataFrame(cHeadDf)
 }
 
 // WithColumns adds or replaces columns in the DataFrame.
 func (df *DataFrame) WithColumns(exprs ...Expr) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(exprs)
+
 	cExprs := make([]*C.CExpr, len(exprs))
 	for i, expr := range exprs {
-		cExprs[i] = expr.ptr
+		cExprs[i] = expr.cptr()
 	}
 
 	cExprsPtr := (**C.CExpr)(unsafe.Pointer(&cExprs[0]))
@@ -400,11 +624,11 @@ func (df *DataFrame) WithColumns(exprs ...Expr) *DataFrame {
 	newDfPtr := C.with_columns(df.ptr, cExprsPtr, cExprsLen)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Lit creates a literal expression.
@@ -435,12 +659,22 @@ func Lit(value interface{}) Expr {
 		panic(fmt.Sprintf("Unsupported literal type: %T", value))
 	}
 
-	return Expr{ptr: (*C.CExpr)(cExpr)}
+	return newExpr(cExpr)
+}
+
+// Free releases the memory associated with the expression and all its copies.
+// It is safe to call Free more than once.
+func (e Expr) Free() {
+	if e.handle != nil {
+		e.handle.free()
+	}
 }
 
 // Free releases the memory associated with the GroupBy.
+// It is safe to call Free more than once.
 func (gb *GroupBy) Free() {
 	if gb.ptr != nil {
+		runtime.SetFinalizer(gb, nil)
 		C.free_groupby(gb.ptr)
 		gb.ptr = nil
 	}
@@ -448,6 +682,9 @@ func (gb *GroupBy) Free() {
 
 // Agg performs aggregation operations on the GroupBy.
 func (gb *GroupBy) Agg(exprs ...Expr) *DataFrame {
+	defer runtime.KeepAlive(gb)
+	defer runtime.KeepAlive(exprs)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -455,7 +692,7 @@ func (gb *GroupBy) Agg(exprs ...Expr) *DataFrame {
 
 	cExprs := make([]*C.CExpr, len(exprs))
 	for i, expr := range exprs {
-		cExprs[i] = expr.ptr
+		cExprs[i] = expr.cptr()
 	}
 
 	cExprsPtr := (**C.CExpr)(unsafe.Pointer(&cExprs[0]))
@@ -464,15 +701,17 @@ func (gb *GroupBy) Agg(exprs ...Expr) *DataFrame {
 	newDfPtr := C.groupby_agg(gb.ptr, cExprsPtr, cExprsLen)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Sum calculates the sum of the specified column for each group.
 func (gb *GroupBy) Sum(column string) *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -484,15 +723,17 @@ func (gb *GroupBy) Sum(column string) *DataFrame {
 	newDfPtr := C.groupby_sum(gb.ptr, cColumn)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Mean calculates the mean of the specified column for each group.
 func (gb *GroupBy) Mean(column string) *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -504,15 +745,17 @@ func (gb *GroupBy) Mean(column string) *DataFrame {
 	newDfPtr := C.groupby_mean(gb.ptr, cColumn)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Count calculates the count of rows for each group.
 func (gb *GroupBy) Count() *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -521,15 +764,17 @@ func (gb *GroupBy) Count() *DataFrame {
 	newDfPtr := C.groupby_count(gb.ptr)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Min calculates the minimum of the specified column for each group.
 func (gb *GroupBy) Min(column string) *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -541,15 +786,17 @@ func (gb *GroupBy) Min(column string) *DataFrame {
 	newDfPtr := C.groupby_min(gb.ptr, cColumn)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Max calculates the maximum of the specified column for each group.
 func (gb *GroupBy) Max(column string) *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -561,15 +808,17 @@ func (gb *GroupBy) Max(column string) *DataFrame {
 	newDfPtr := C.groupby_max(gb.ptr, cColumn)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
 }
 
 // Std calculates the standard deviation of the specified column for each group.
 func (gb *GroupBy) Std(column string) *DataFrame {
+	defer runtime.KeepAlive(gb)
+
 	if gb.ptr == nil {
 		log.Println("error: GroupBy is nil")
 		return &DataFrame{}
@@ -581,45 +830,302 @@ func (gb *GroupBy) Std(column string) *DataFrame {
 	newDfPtr := C.groupby_std(gb.ptr, cColumn)
 
 	if newDfPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(newDfPtr)}
+	return newDataFrame(newDfPtr)
+}
+
+// GroupByDynamic groups the DataFrame into time windows on indexCol.
+// A new window starts every interval (e.g. "1h", "1d", "2i") and spans period,
+// shifted by offset. An empty period defaults to every and an empty offset to
+// zero. The DataFrame must be sorted by indexCol. Optional by columns group the
+// windows per key as well.
+func (df *DataFrame) GroupByDynamic(indexCol, every, period, offset string, by ...string) *GroupBy {
+	defer runtime.KeepAlive(df)
+
+	if df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &GroupBy{}
+	}
+
+	cIndexCol := C.CString(indexCol)
+	defer C.free(unsafe.Pointer(cIndexCol))
+
+	cEvery := C.CString(every)
+	defer C.free(unsafe.Pointer(cEvery))
+
+	cPeriod := C.CString(period)
+	defer C.free(unsafe.Pointer(cPeriod))
+
+	cOffset := C.CString(offset)
+	defer C.free(unsafe.Pointer(cOffset))
+
+	cBy, freeBy := cStringArray(by)
+	defer freeBy()
+
+	gbPtr := C.group_by_dynamic(df.ptr, cIndexCol, cEvery, cPeriod, cOffset, cBy, C.int(len(by)))
+	if gbPtr == nil {
+		log.Printf("error: %s", errors.New(lastError()))
+		return &GroupBy{}
+	}
+
+	return newGroupBy(gbPtr)
+}
+
+// Rolling groups the DataFrame into one look-back window per row: each group
+// holds the rows whose indexCol falls in (t - period, t]. The DataFrame must be
+// sorted by indexCol. Optional by columns restrict the windows to each key.
+func (df *DataFrame) Rolling(indexCol, period string, by ...string) *GroupBy {
+	defer runtime.KeepAlive(df)
+
+	if df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &GroupBy{}
+	}
+
+	cIndexCol := C.CString(indexCol)
+	defer C.free(unsafe.Pointer(cIndexCol))
+
+	cPeriod := C.CString(period)
+	defer C.free(unsafe.Pointer(cPeriod))
+
+	cBy, freeBy := cStringArray(by)
+	defer freeBy()
+
+	gbPtr := C.rolling(df.ptr, cIndexCol, cPeriod, cBy, C.int(len(by)))
+	if gbPtr == nil {
+		log.Printf("error: %s", errors.New(lastError()))
+		return &GroupBy{}
+	}
+
+	return newGroupBy(gbPtr)
 }
 
 // Sum creates a sum aggregation expression.
 func (e Expr) Sum() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_sum(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_sum(e.cptr()))
 }
 
 // Mean creates a mean aggregation expression.
 func (e Expr) Mean() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_mean(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_mean(e.cptr()))
 }
 
 // Min creates a min aggregation expression.
 func (e Expr) Min() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_min(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_min(e.cptr()))
 }
 
 // Max creates a max aggregation expression.
 func (e Expr) Max() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_max(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_max(e.cptr()))
 }
 
 // Std creates a standard deviation aggregation expression.
 func (e Expr) Std() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_std(e.ptr))}
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_std(e.cptr()))
+}
+
+// Median creates a median aggregation expression.
+func (e Expr) Median() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_median(e.cptr()))
+}
+
+// QuantileInterpolation represents the interpolation method used by Quantile.
+type QuantileInterpolation string
+
+const (
+	QuantileNearest  QuantileInterpolation = "nearest"
+	QuantileLower    QuantileInterpolation = "lower"
+	QuantileHigher   QuantileInterpolation = "higher"
+	QuantileMidpoint QuantileInterpolation = "midpoint"
+	QuantileLinear   QuantileInterpolation = "linear"
+)
+
+// Quantile creates a quantile aggregation expression for q in [0, 1].
+func (e Expr) Quantile(q float64, interpolation QuantileInterpolation) Expr {
+	defer runtime.KeepAlive(e)
+
+	var cMethod C.CQuantileMethod
+	switch interpolation {
+	case QuantileNearest:
+		cMethod = C.QUANTILE_NEAREST
+	case QuantileLower:
+		cMethod = C.QUANTILE_LOWER
+	case QuantileHigher:
+		cMethod = C.QUANTILE_HIGHER
+	case QuantileMidpoint:
+		cMethod = C.QUANTILE_MIDPOINT
+	case QuantileLinear:
+		cMethod = C.QUANTILE_LINEAR
+	default:
+		log.Printf("error: unknown quantile interpolation %q", interpolation)
+		return Expr{}
+	}
+	return newExpr(C.expr_quantile(e.cptr(), C.double(q), cMethod))
+}
+
+// Var creates a variance aggregation expression.
+func (e Expr) Var() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_var(e.cptr()))
+}
+
+// First creates an expression selecting the first value.
+func (e Expr) First() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_first(e.cptr()))
+}
+
+// Last creates an expression selecting the last value.
+func (e Expr) Last() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_last(e.cptr()))
+}
+
+// NUnique creates an expression counting the distinct values.
+func (e Expr) NUnique() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_n_unique(e.cptr()))
+}
+
+// NullCount creates an expression counting the null values.
+func (e Expr) NullCount() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_null_count(e.cptr()))
+}
+
+// Unique creates an expression with the distinct values, in order of first
+// appearance.
+func (e Expr) Unique() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_unique(e.cptr()))
+}
+
+// IsUnique creates a boolean expression that is true for values occurring
+// exactly once.
+func (e Expr) IsUnique() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_is_unique(e.cptr()))
+}
+
+// IsFirstDistinct creates a boolean expression that is true for the first
+// occurrence of each value.
+func (e Expr) IsFirstDistinct() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_is_first_distinct(e.cptr()))
+}
+
+// AggList creates an expression collecting the values into a list.
+func (e Expr) AggList() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_implode(e.cptr()))
+}
+
+// Len creates an expression counting the values, including nulls.
+func (e Expr) Len() Expr {
+	defer runtime.KeepAlive(e)
+
+	return newExpr(C.expr_len(e.cptr()))
 }
 
 // Count creates a count aggregation expression.
 func Count() Expr {
-	return Expr{ptr: (*C.CExpr)(C.expr_count())}
+	return newExpr(C.expr_count())
+}
+
+// RollingSum creates a sum over a sliding window of window rows.
+// Rows before the window is full are null.
+func (e Expr) RollingSum(window int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if window <= 0 {
+		log.Printf("error: non-positive rolling window %d", window)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_rolling_sum(e.cptr(), C.size_t(window)))
+}
+
+// RollingMean creates a mean over a sliding window of window rows.
+// Rows before the window is full are null.
+func (e Expr) RollingMean(window int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if window <= 0 {
+		log.Printf("error: non-positive rolling window %d", window)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_rolling_mean(e.cptr(), C.size_t(window)))
+}
+
+// RollingMin creates a minimum over a sliding window of window rows.
+// Rows before the window is full are null.
+func (e Expr) RollingMin(window int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if window <= 0 {
+		log.Printf("error: non-positive rolling window %d", window)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_rolling_min(e.cptr(), C.size_t(window)))
+}
+
+// RollingMax creates a maximum over a sliding window of window rows.
+// Rows before the window is full are null.
+func (e Expr) RollingMax(window int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if window <= 0 {
+		log.Printf("error: non-positive rolling window %d", window)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_rolling_max(e.cptr(), C.size_t(window)))
+}
+
+// RollingStd creates a standard deviation over a sliding window of window rows.
+// Rows before the window is full are null.
+func (e Expr) RollingStd(window int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if window <= 0 {
+		log.Printf("error: non-positive rolling window %d", window)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_rolling_std(e.cptr(), C.size_t(window)))
 }
 
 // Sort sorts the DataFrame by one or more columns in ascending order.
 func (df *DataFrame) Sort(columns ...string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
 	if df.ptr == nil {
 		log.Println("error: DataFrame is nil")
 		return &DataFrame{}
@@ -651,11 +1157,11 @@ func (df *DataFrame) Sort(columns ...string) *DataFrame {
 
 	sortedPtr := C.sort_by_columns(df.ptr, cColumns, cDescending)
 	if sortedPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(sortedPtr)}
+	return newDataFrame(sortedPtr)
 }
 
 // JoinType represents the type of join operation
@@ -666,8 +1172,110 @@ const (
 	JoinLeft  JoinType = "left"
 	JoinRight JoinType = "right"
 	JoinOuter JoinType = "outer"
+	// JoinSemi keeps the left rows that have a match, without adding right columns.
+	JoinSemi JoinType = "semi"
+	// JoinAnti keeps the left rows that have no match, without adding right columns.
+	JoinAnti JoinType = "anti"
+	// JoinCross pairs every left row with every right row and takes no keys.
+	JoinCross JoinType = "cross"
+)
+
+func (how JoinType) toC() (C.CJoinType, error) {
+	switch how {
+	case JoinInner:
+		return C.JOIN_INNER, nil
+	case JoinLeft:
+		return C.JOIN_LEFT, nil
+	case JoinRight:
+		return C.JOIN_RIGHT, nil
+	case JoinOuter:
+		return C.JOIN_OUTER, nil
+	case JoinSemi:
+		return C.JOIN_SEMI, nil
+	case JoinAnti:
+		return C.JOIN_ANTI, nil
+	case JoinCross:
+		return C.JOIN_CROSS, nil
+	default:
+		return 0, fmt.Errorf("unknown join type %s", how)
+	}
+}
+
+// JoinValidation checks the uniqueness of join keys before joining.
+type JoinValidation string
+
+const (
+	// ValidateManyToMany performs no check.
+	ValidateManyToMany JoinValidation = "m:m"
+	// ValidateOneToOne requires keys to be unique on both sides.
+	ValidateOneToOne JoinValidation = "1:1"
+	// ValidateOneToMany requires keys to be unique on the left side.
+	ValidateOneToMany JoinValidation = "1:m"
+	// ValidateManyToOne requires keys to be unique on the right side.
+	ValidateManyToOne JoinValidation = "m:1"
+)
+
+// JoinCoalesce controls whether the key columns of both sides are merged.
+type JoinCoalesce string
+
+const (
+	// CoalesceDefault merges key columns for every join type except outer joins.
+	CoalesceDefault JoinCoalesce = ""
+	// CoalesceColumns always merges the left and right key columns.
+	CoalesceColumns JoinCoalesce = "coalesce"
+	// CoalesceKeep keeps the right key columns, renamed with the suffix.
+	CoalesceKeep JoinCoalesce = "keep"
 )
 
+// JoinOptions configures JoinColumns and JoinOnExprs. The zero value matches
+// Join: no validation, a "_right" suffix and nulls never matching.
+type JoinOptions struct {
+	// Suffix is appended to right column names that clash with left ones.
+	Suffix string
+	// Validate makes the join fail if the keys are not unique as declared.
+	Validate JoinValidation
+	// Coalesce controls whether the key columns of both sides are merged.
+	Coalesce JoinCoalesce
+	// NullsEqual makes null keys match each other.
+	NullsEqual bool
+}
+
+func (opts JoinOptions) toC() (C.CJoinOptions, func(), error) {
+	var cOpts C.CJoinOptions
+
+	switch opts.Validate {
+	case "", ValidateManyToMany:
+		cOpts.validation = C.JOIN_VALIDATE_MANY_TO_MANY
+	case ValidateOneToOne:
+		cOpts.validation = C.JOIN_VALIDATE_ONE_TO_ONE
+	case ValidateOneToMany:
+		cOpts.validation = C.JOIN_VALIDATE_ONE_TO_MANY
+	case ValidateManyToOne:
+		cOpts.validation = C.JOIN_VALIDATE_MANY_TO_ONE
+	default:
+		return cOpts, nil, fmt.Errorf("unknown join validation %s", opts.Validate)
+	}
+
+	switch opts.Coalesce {
+	case CoalesceDefault:
+		cOpts.coalesce = C.JOIN_COALESCE_DEFAULT
+	case CoalesceColumns:
+		cOpts.coalesce = C.JOIN_COALESCE_COALESCE
+	case CoalesceKeep:
+		cOpts.coalesce = C.JOIN_COALESCE_KEEP
+	default:
+		return cOpts, nil, fmt.Errorf("unknown join coalesce option %s", opts.Coalesce)
+	}
+
+	if opts.NullsEqual {
+		cOpts.nulls_equal = 1
+	}
+
+	cSuffix := C.CString(opts.Suffix)
+	cOpts.suffix = cSuffix
+	return cOpts, func() { C.free(unsafe.Pointer(cSuffix)) }, nil
+}
+
 // Join performs a join operation with another DataFrame on matching columns
 func (df *DataFrame) Join(other *DataFrame, on string, how JoinType) *DataFrame {
 	return df.JoinOn(other, on, on, how)
@@ -675,6 +1283,9 @@ func (df *DataFrame) Join(other *DataFrame, on string, how JoinType) *DataFrame
 
 // JoinOn performs a join operation with another DataFrame using different column names for left and right
 func (df *DataFrame) JoinOn(other *DataFrame, leftOn, rightOn string, how JoinType) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(other)
+
 	if df == nil || df.ptr == nil {
 		log.Println("error: left DataFrame is nil")
 		return &DataFrame{}
@@ -691,34 +1302,30 @@ func (df *DataFrame) JoinOn(other *DataFrame, leftOn, rightOn string, how JoinTy
 	cRightOn := C.CString(rightOn)
 	defer C.free(unsafe.Pointer(cRightOn))
 
-	var cJoinType C.CJoinType
-	switch how {
-	case JoinInner:
-		cJoinType = C.JOIN_INNER
-	case JoinLeft:
-		cJoinType = C.JOIN_LEFT
-	case JoinRight:
-		cJoinType = C.JOIN_RIGHT
-	case JoinOuter:
-		cJoinType = C.JOIN_OUTER
-	default:
-		log.Printf("error: unknown join type %s", how)
+	cJoinType, err := how.toC()
+	if err != nil {
+		log.Printf("error: %s", err)
 		return &DataFrame{}
 	}
 
 	joinedPtr := C.join_dataframes(df.ptr, other.ptr, cLeftOn, cRightOn, cJoinType)
 	if joinedPtr == nil {
-		err := errors.New(C.GoString(C.get_last_error_message()))
+		err = errors.New(lastError())
 		log.Printf("Error while joining: %s", err)
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(joinedPtr)}
+	return newDataFrame(joinedPtr)
 }
 
 // JoinMultiple performs a join operation with multiple key columns
 // leftOn and rightOn should be comma-separated column names
+//
+// Deprecated: Use JoinColumns, which also supports column names containing commas.
 func (df *DataFrame) JoinMultiple(other *DataFrame, leftOn, rightOn string, how JoinType) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(other)
+
 	if df == nil || df.ptr == nil {
 		log.Println("error: left DataFrame is nil")
 		return &DataFrame{}
@@ -735,43 +1342,709 @@ func (df *DataFrame) JoinMultiple(other *DataFrame, leftOn, rightOn string, how
 	cRightOn := C.CString(rightOn)
 	defer C.free(unsafe.Pointer(cRightOn))
 
-	var cJoinType C.CJoinType
-	switch how {
-	case JoinInner:
-		cJoinType = C.JOIN_INNER
-	case JoinLeft:
-		cJoinType = C.JOIN_LEFT
-	case JoinRight:
-		cJoinType = C.JOIN_RIGHT
-	case JoinOuter:
-		cJoinType = C.JOIN_OUTER
-	default:
-		log.Printf("error: unknown join type %s", how)
+	cJoinType, err := how.toC()
+	if err != nil {
+		log.Printf("error: %s", err)
 		return &DataFrame{}
 	}
 
 	joinedPtr := C.join_dataframes_multiple_keys(df.ptr, other.ptr, cLeftOn, cRightOn, cJoinType)
 	if joinedPtr == nil {
-		err := errors.New(C.GoString(C.get_last_error_message()))
+		err = errors.New(lastError())
 		log.Printf("Error while joining: %s", err)
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(joinedPtr)}
+	return newDataFrame(joinedPtr)
 }
 
-// DataFrameBuilder provides a fluent API for building DataFrames with mixed column types.
-type DataFrameBuilder struct {
-	columns  []columnSpec
-	rowCount int
-	hasRows  bool
+// JoinColumns joins with another DataFrame on one or more pairs of key columns.
+// An optional JoinOptions sets the suffix, key validation, coalescing and
+// null matching.
+func (df *DataFrame) JoinColumns(other *DataFrame, leftOn, rightOn []string, how JoinType, opts ...JoinOptions) *DataFrame {
+	leftExprs := make([]Expr, len(leftOn))
+	for i, name := range leftOn {
+		leftExprs[i] = Col(name)
+	}
+	rightExprs := make([]Expr, len(rightOn))
+	for i, name := range rightOn {
+		rightExprs[i] = Col(name)
+	}
+	return df.JoinOnExprs(other, leftExprs, rightExprs, how, opts...)
 }
 
-type columnSpec struct {
-	name       string
-	columnType C.CColumnType
-	data       interface{}
+// JoinOnExprs joins with another DataFrame on pairs of key expressions, such as
+// columns or values computed from them. An optional JoinOptions sets the
+// suffix, key validation, coalescing and null matching.
+func (df *DataFrame) JoinOnExprs(other *DataFrame, leftOn, rightOn []Expr, how JoinType, opts ...JoinOptions) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(other)
+	defer runtime.KeepAlive(leftOn)
+	defer runtime.KeepAlive(rightOn)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: left DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if other == nil || other.ptr == nil {
+		log.Println("error: right DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if len(leftOn) != len(rightOn) {
+		log.Printf("error: expected the same number of left and right join keys, got %d and %d", len(leftOn), len(rightOn))
+		return &DataFrame{}
+	}
+
+	cJoinType, err := how.toC()
+	if err != nil {
+		log.Printf("error: %s", err)
+		return &DataFrame{}
+	}
+
+	var options JoinOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	cOpts, freeOpts, err := options.toC()
+	if err != nil {
+		log.Printf("error: %s", err)
+		return &DataFrame{}
+	}
+	defer freeOpts()
+
+	// Cross joins take no keys, so the key arrays may be empty
+	var cLeftOn, cRightOn **C.CExpr
+	if len(leftOn) > 0 {
+		cLeftExprs := make([]*C.CExpr, len(leftOn))
+		for i, expr := range leftOn {
+			cLeftExprs[i] = expr.cptr()
+		}
+		cRightExprs := make([]*C.CExpr, len(rightOn))
+		for i, expr := range rightOn {
+			cRightExprs[i] = expr.cptr()
+		}
+		cLeftOn, cRightOn = &cLeftExprs[0], &cRightExprs[0]
+	}
+
+	joinedPtr := C.join_dataframes_exprs(
+		df.ptr, other.ptr,
+		cLeftOn, C.int(len(leftOn)),
+		cRightOn, C.int(len(rightOn)),
+		cJoinType, cOpts,
+	)
+	if joinedPtr == nil {
+		err = errors.New(lastError())
+		log.Printf("Error while joining: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(joinedPtr)
+}
+
+// JoinWhere joins every pair of rows for which all predicates hold, such as
+// range conditions matching events to the sessions they fall into. Right
+// columns whose names clash with left ones are suffixed with "_right" and
+// must be referred to by that name in the predicates.
+func (df *DataFrame) JoinWhere(other *DataFrame, predicates ...Expr) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(other)
+	defer runtime.KeepAlive(predicates)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: left DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if other == nil || other.ptr == nil {
+		log.Println("error: right DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if len(predicates) == 0 {
+		log.Println("error: JoinWhere requires at least one predicate")
+		return &DataFrame{}
+	}
+
+	cPredicates := make([]*C.CExpr, len(predicates))
+	for i, expr := range predicates {
+		cPredicates[i] = expr.cptr()
+	}
+
+	joinedPtr := C.join_where(df.ptr, other.ptr, &cPredicates[0], C.int(len(cPredicates)))
+	if joinedPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while joining: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(joinedPtr)
+}
+
+// CrossJoin returns the Cartesian product of the two DataFrames. An optional
+// JoinOptions sets the suffix for clashing column names.
+func (df *DataFrame) CrossJoin(other *DataFrame, opts ...JoinOptions) *DataFrame {
+	return df.JoinOnExprs(other, nil, nil, JoinCross, opts...)
+}
+
+// AsofStrategy selects which right row an as-of join matches.
+type AsofStrategy string
+
+const (
+	// AsofBackward matches the last right row whose key is less than or equal to the left key.
+	AsofBackward AsofStrategy = "backward"
+	// AsofForward matches the first right row whose key is greater than or equal to the left key.
+	AsofForward AsofStrategy = "forward"
+	// AsofNearest matches the right row whose key is closest to the left key.
+	AsofNearest AsofStrategy = "nearest"
+)
+
+// JoinAsof matches each row with the closest row of other by key rather than
+// by equal keys, for example to align trades with the latest quote. Both
+// DataFrames must be sorted by their key. Rows are only matched within the
+// same by groups, if any. tolerance limits the distance between matched keys
+// and is a number for numeric keys or a duration such as "5m" for temporal
+// keys; an empty tolerance matches at any distance.
+func (df *DataFrame) JoinAsof(other *DataFrame, leftOn, rightOn string, strategy AsofStrategy, by []string, tolerance string) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(other)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: left DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if other == nil || other.ptr == nil {
+		log.Println("error: right DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	var cStrategy C.CAsofStrategy
+	switch strategy {
+	case AsofBackward:
+		cStrategy = C.ASOF_BACKWARD
+	case AsofForward:
+		cStrategy = C.ASOF_FORWARD
+	case AsofNearest:
+		cStrategy = C.ASOF_NEAREST
+	default:
+		log.Printf("error: unknown as-of strategy %s", strategy)
+		return &DataFrame{}
+	}
+
+	cLeftOn := C.CString(leftOn)
+	defer C.free(unsafe.Pointer(cLeftOn))
+
+	cRightOn := C.CString(rightOn)
+	defer C.free(unsafe.Pointer(cRightOn))
+
+	cTolerance := C.CString(tolerance)
+	defer C.free(unsafe.Pointer(cTolerance))
+
+	cBy, freeBy := cStringArray(by)
+	defer freeBy()
+
+	joinedPtr := C.join_asof(df.ptr, other.ptr, cLeftOn, cRightOn, cStrategy, cBy, C.int(len(by)), cTolerance)
+	if joinedPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while joining: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(joinedPtr)
+}
+
+// cStringArray copies strs into a C array of C strings. The returned function
+// releases the array and must be called once it is no longer needed.
+func cStringArray(strs []string) (**C.char, func()) {
+	if len(strs) == 0 {
+		return nil, func() {}
+	}
+
+	array := (**C.char)(C.malloc(C.size_t(len(strs)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+	elems := unsafe.Slice(array, len(strs))
+	for i, s := range strs {
+		elems[i] = C.CString(s)
+	}
+
+	return array, func() {
+		for _, elem := range elems {
+			C.free(unsafe.Pointer(elem))
+		}
+		C.free(unsafe.Pointer(array))
+	}
+}
+
+// ConcatMethod selects how Concat combines DataFrames.
+type ConcatMethod string
+
+const (
+	// ConcatVertical stacks rows; all frames must have the same schema.
+	ConcatVertical ConcatMethod = "vertical"
+	// ConcatVerticalRelaxed stacks rows, casting columns to a common supertype.
+	ConcatVerticalRelaxed ConcatMethod = "vertical_relaxed"
+	// ConcatDiagonal stacks rows, taking the union of the columns and filling
+	// missing ones with nulls.
+	ConcatDiagonal ConcatMethod = "diagonal"
+	// ConcatHorizontal places the columns of the frames side by side.
+	ConcatHorizontal ConcatMethod = "horizontal"
+)
+
+// Concat combines several DataFrames into one.
+func Concat(frames []*DataFrame, how ConcatMethod) *DataFrame {
+	defer runtime.KeepAlive(frames)
+
+	if len(frames) == 0 {
+		log.Println("error: Concat requires at least one DataFrame")
+		return &DataFrame{}
+	}
+
+	var cHow C.CConcatMethod
+	switch how {
+	case ConcatVertical:
+		cHow = C.CONCAT_VERTICAL
+	case ConcatVerticalRelaxed:
+		cHow = C.CONCAT_VERTICAL_RELAXED
+	case ConcatDiagonal:
+		cHow = C.CONCAT_DIAGONAL
+	case ConcatHorizontal:
+		cHow = C.CONCAT_HORIZONTAL
+	default:
+		log.Printf("error: unknown concat method %s", how)
+		return &DataFrame{}
+	}
+
+	cFrames := make([]*C.CDataFrame, len(frames))
+	for i, df := range frames {
+		if df == nil || df.ptr == nil {
+			log.Printf("error: DataFrame %d is nil", i)
+			return &DataFrame{}
+		}
+		cFrames[i] = df.ptr
+	}
+
+	concatPtr := C.concat_dataframes(&cFrames[0], C.int(len(cFrames)), cHow)
+	if concatPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while concatenating: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(concatPtr)
+}
+
+// UniqueKeep selects which row of each group of duplicates Unique keeps.
+type UniqueKeep string
+
+const (
+	// UniqueKeepFirst keeps the first occurrence.
+	UniqueKeepFirst UniqueKeep = "first"
+	// UniqueKeepLast keeps the last occurrence.
+	UniqueKeepLast UniqueKeep = "last"
+	// UniqueKeepNone drops every row that has a duplicate.
+	UniqueKeepNone UniqueKeep = "none"
+	// UniqueKeepAny keeps one occurrence, whichever is cheapest to find.
+	UniqueKeepAny UniqueKeep = "any"
+)
+
+// Unique removes duplicate rows. Only the subset columns are compared if any
+// are given. If maintainOrder is true, the remaining rows keep their original
+// order, which is slower.
+func (df *DataFrame) Unique(subset []string, keep UniqueKeep, maintainOrder bool) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	var cKeep C.CUniqueKeep
+	switch keep {
+	case UniqueKeepFirst:
+		cKeep = C.UNIQUE_KEEP_FIRST
+	case UniqueKeepLast:
+		cKeep = C.UNIQUE_KEEP_LAST
+	case UniqueKeepNone:
+		cKeep = C.UNIQUE_KEEP_NONE
+	case UniqueKeepAny:
+		cKeep = C.UNIQUE_KEEP_ANY
+	default:
+		log.Printf("error: unknown keep strategy %s", keep)
+		return &DataFrame{}
+	}
+
+	cSubset, freeSubset := cStringArray(subset)
+	defer freeSubset()
+
+	var cMaintainOrder C.uint8_t
+	if maintainOrder {
+		cMaintainOrder = 1
+	}
+
+	uniquePtr := C.dataframe_unique(df.ptr, cSubset, C.int(len(subset)), cKeep, cMaintainOrder)
+	if uniquePtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error removing duplicates: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(uniquePtr)
+}
+
+// IsDuplicated returns a boolean Series named "is_duplicated" that is true for
+// every row occurring more than once.
+func (df *DataFrame) IsDuplicated() *Series {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &Series{}
+	}
+
+	return wrapSeries(C.dataframe_is_duplicated(df.ptr), "finding duplicates")
+}
+
+// NUnique returns the number of distinct rows.
+func (df *DataFrame) NUnique() (int, error) {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		return 0, nil
+	}
+
+	n := int(C.dataframe_n_unique(df.ptr))
+	if n < 0 {
+		return 0, errors.New(lastError())
+	}
+	return n, nil
+}
+
+// PivotAgg selects how Pivot combines the values that fall into the same cell.
+type PivotAgg string
+
+const (
+	PivotFirst  PivotAgg = "first"
+	PivotLast   PivotAgg = "last"
+	PivotSum    PivotAgg = "sum"
+	PivotMean   PivotAgg = "mean"
+	PivotMedian PivotAgg = "median"
+	PivotMin    PivotAgg = "min"
+	PivotMax    PivotAgg = "max"
+	// PivotLen counts the values in each cell.
+	PivotLen PivotAgg = "len"
+)
+
+// Pivot reshapes the DataFrame from long to wide format: each distinct value
+// of the on columns becomes a new column, with one row per distinct index and
+// cells filled from the values columns using aggregateFn. An empty index or
+// values selects all remaining columns. Columns appear in order of first
+// appearance.
+func (df *DataFrame) Pivot(on, index, values []string, aggregateFn PivotAgg) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	var cAgg C.CPivotAgg
+	switch aggregateFn {
+	case PivotFirst:
+		cAgg = C.PIVOT_FIRST
+	case PivotLast:
+		cAgg = C.PIVOT_LAST
+	case PivotSum:
+		cAgg = C.PIVOT_SUM
+	case PivotMean:
+		cAgg = C.PIVOT_MEAN
+	case PivotMedian:
+		cAgg = C.PIVOT_MEDIAN
+	case PivotMin:
+		cAgg = C.PIVOT_MIN
+	case PivotMax:
+		cAgg = C.PIVOT_MAX
+	case PivotLen:
+		cAgg = C.PIVOT_LEN
+	default:
+		log.Printf("error: unknown pivot aggregation %s", aggregateFn)
+		return &DataFrame{}
+	}
+
+	cOn, freeOn := cStringArray(on)
+	defer freeOn()
+
+	cIndex, freeIndex := cStringArray(index)
+	defer freeIndex()
+
+	cValues, freeValues := cStringArray(values)
+	defer freeValues()
+
+	pivotPtr := C.dataframe_pivot(df.ptr, cOn, C.int(len(on)), cIndex, C.int(len(index)), cValues, C.int(len(values)), cAgg)
+	if pivotPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while pivoting: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(pivotPtr)
+}
+
+// Unpivot reshapes the DataFrame from wide to long format, the inverse of
+// Pivot: each of the on columns becomes rows holding the column name in
+// variableName and its value in valueName, with the index columns repeated.
+// An empty on selects all non-index columns, and empty names default to
+// "variable" and "value".
+func (df *DataFrame) Unpivot(index, on []string, variableName, valueName string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cIndex, freeIndex := cStringArray(index)
+	defer freeIndex()
+
+	cOn, freeOn := cStringArray(on)
+	defer freeOn()
+
+	cVariableName := C.CString(variableName)
+	defer C.free(unsafe.Pointer(cVariableName))
+
+	cValueName := C.CString(valueName)
+	defer C.free(unsafe.Pointer(cValueName))
+
+	unpivotPtr := C.dataframe_unpivot(df.ptr, cIndex, C.int(len(index)), cOn, C.int(len(on)), cVariableName, cValueName)
+	if unpivotPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while unpivoting: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(unpivotPtr)
+}
+
+// DataFrameBuilder provides a fluent API for building DataFrames with mixed column types.
+type DataFrameBuilder struct {
+	columns  []columnSpec
+	rowCount int
+	hasRows  bool
+	// err is the first error of a column that could not be added, returned
+	// by Build.
+	err error
+}
+
+type columnSpec struct {
+	name       string
+	columnType C.CColumnType
+	data       interface{}
 	length     int
+	// precision and scale describe a decimal column.
+	precision, scale int
+}
+
+// listColumn holds the flattened values of a list column and, for each row,
+// the offset of its first value, followed by the total number of values.
+type listColumn struct {
+	values  columnSpec
+	offsets []int64
+}
+
+// newColumnSpec describes a column holding values, which must be a slice of a
+// supported type or a slice of such slices for a list column.
+func newColumnSpec(name string, values any) (columnSpec, error) {
+	col := columnSpec{name: name, data: values}
+	switch v := values.(type) {
+	case []string:
+		col.columnType, col.length = C.COLUMN_STRING, len(v)
+	case []int64:
+		col.columnType, col.length = C.COLUMN_INT64, len(v)
+	case []float64:
+		col.columnType, col.length = C.COLUMN_FLOAT64, len(v)
+	case []bool:
+		col.columnType, col.length = C.COLUMN_BOOL, len(v)
+	case []time.Time:
+		col.columnType, col.length = C.COLUMN_DATETIME, len(v)
+	case [][]string:
+		return newListColumnSpec(name, v)
+	case [][]int64:
+		return newListColumnSpec(name, v)
+	case [][]float64:
+		return newListColumnSpec(name, v)
+	case [][]bool:
+		return newListColumnSpec(name, v)
+	case [][]time.Time:
+		return newListColumnSpec(name, v)
+	default:
+		return columnSpec{}, fmt.Errorf("unsupported column values type %T", values)
+	}
+	return col, nil
+}
+
+func newListColumnSpec[T any](name string, lists [][]T) (columnSpec, error) {
+	var values []T
+	offsets := make([]int64, len(lists)+1)
+	for i, list := range lists {
+		values = append(values, list...)
+		offsets[i+1] = int64(len(values))
+	}
+
+	inner, err := newColumnSpec("", values)
+	if err != nil {
+		return columnSpec{}, err
+	}
+
+	return columnSpec{
+		name:       name,
+		columnType: C.COLUMN_LIST,
+		data:       listColumn{values: inner, offsets: offsets},
+		length:     len(lists),
+	}, nil
+}
+
+// marshal fills cSpec with C copies of the column's name and values. The
+// returned pointers must be released with C.free once the spec has been used.
+func (col columnSpec) marshal(cSpec *C.CColumnSpec) []unsafe.Pointer {
+	var managedMemory []unsafe.Pointer
+
+	// Set column name
+	cName := C.CString(col.name)
+	managedMemory = append(managedMemory, unsafe.Pointer(cName))
+
+	cSpec.name = cName
+	cSpec.column_type = col.columnType
+	cSpec.length = C.int(col.length)
+	cSpec.precision = C.int(col.precision)
+	cSpec.scale = C.int(col.scale)
+
+	// Handle data based on type
+	switch col.columnType {
+	case C.COLUMN_STRING:
+		values := col.data.([]string)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			// Create array of C string pointers
+			cStringPtrs := (*C.char)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cStringPtrs))
+
+			cStringArray := (*[1 << 30]*C.char)(unsafe.Pointer(cStringPtrs))[:len(values):len(values)]
+			for j, str := range values {
+				cStr := C.CString(str)
+				managedMemory = append(managedMemory, unsafe.Pointer(cStr))
+				cStringArray[j] = cStr
+			}
+			cSpec.data = unsafe.Pointer(cStringPtrs)
+		}
+
+	case C.COLUMN_INT64:
+		values := col.data.([]int64)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			cIntData := (*C.longlong)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.longlong(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cIntData))
+
+			cIntArray := (*[1 << 30]C.longlong)(unsafe.Pointer(cIntData))[:len(values):len(values)]
+			for j, val := range values {
+				cIntArray[j] = C.longlong(val)
+			}
+			cSpec.data = unsafe.Pointer(cIntData)
+		}
+
+	case C.COLUMN_FLOAT64:
+		values := col.data.([]float64)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			cFloatData := (*C.double)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.double(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cFloatData))
+
+			cFloatArray := (*[1 << 30]C.double)(unsafe.Pointer(cFloatData))[:len(values):len(values)]
+			for j, val := range values {
+				cFloatArray[j] = C.double(val)
+			}
+			cSpec.data = unsafe.Pointer(cFloatData)
+		}
+
+	case C.COLUMN_BOOL:
+		values := col.data.([]bool)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			cBoolData := (*C.uchar)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.uchar(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cBoolData))
+
+			cBoolArray := (*[1 << 30]C.uchar)(unsafe.Pointer(cBoolData))[:len(values):len(values)]
+			for j, val := range values {
+				if val {
+					cBoolArray[j] = 1
+				} else {
+					cBoolArray[j] = 0
+				}
+			}
+			cSpec.data = unsafe.Pointer(cBoolData)
+		}
+
+	case C.COLUMN_DATETIME:
+		values := col.data.([]time.Time)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			cMicrosData := (*C.longlong)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.longlong(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cMicrosData))
+
+			cMicrosArray := (*[1 << 30]C.longlong)(unsafe.Pointer(cMicrosData))[:len(values):len(values)]
+			for j, val := range values {
+				cMicrosArray[j] = C.longlong(val.UnixMicro())
+			}
+			cSpec.data = unsafe.Pointer(cMicrosData)
+		}
+
+	case C.COLUMN_LIST:
+		list := col.data.(listColumn)
+
+		cValuesSpec := (*C.CColumnSpec)(C.calloc(1, C.size_t(unsafe.Sizeof(C.CColumnSpec{}))))
+		managedMemory = append(managedMemory, unsafe.Pointer(cValuesSpec))
+		managedMemory = append(managedMemory, list.values.marshal(cValuesSpec)...)
+		cSpec.data = unsafe.Pointer(cValuesSpec)
+
+		cOffsetData := (*C.int64_t)(C.malloc(C.size_t(len(list.offsets)) * C.size_t(unsafe.Sizeof(C.int64_t(0)))))
+		managedMemory = append(managedMemory, unsafe.Pointer(cOffsetData))
+
+		cOffsetArray := unsafe.Slice(cOffsetData, len(list.offsets))
+		for j, offset := range list.offsets {
+			cOffsetArray[j] = C.int64_t(offset)
+		}
+		cSpec.offsets = cOffsetData
+
+	case C.COLUMN_DECIMAL:
+		values := col.data.([]*big.Rat)
+		if len(values) == 0 {
+			cSpec.data = nil
+		} else {
+			// Decimals cross as strings so that no precision is lost; nil
+			// values become null pointers.
+			cStringPtrs := (**C.char)(C.calloc(C.size_t(len(values)), C.size_t(unsafe.Sizeof(uintptr(0)))))
+			managedMemory = append(managedMemory, unsafe.Pointer(cStringPtrs))
+
+			cStringArray := unsafe.Slice(cStringPtrs, len(values))
+			for j, val := range values {
+				if val == nil {
+					continue
+				}
+				// AddDecimalColumn checked that val has at most scale
+				// fractional digits, so nothing is rounded here.
+				cStr := C.CString(val.FloatString(col.scale))
+				managedMemory = append(managedMemory, unsafe.Pointer(cStr))
+				cStringArray[j] = cStr
+			}
+			cSpec.data = unsafe.Pointer(cStringPtrs)
+		}
+	}
+
+	return managedMemory
 }
 
 // NewDataFrame creates a new DataFrameBuilder.
@@ -841,6 +2114,37 @@ func (b *DataFrameBuilder) AddBoolColumn(name string, values []bool) *DataFrameB
 	return b
 }
 
+// AddDatetimeColumn adds a datetime column (microsecond precision) to the DataFrame.
+func (b *DataFrameBuilder) AddDatetimeColumn(name string, values []time.Time) *DataFrameBuilder {
+	if err := b.validateColumnLength(len(values)); err != nil {
+		return b
+	}
+
+	b.columns = append(b.columns, columnSpec{
+		name:       name,
+		columnType: C.COLUMN_DATETIME,
+		data:       values,
+		length:     len(values),
+	})
+	return b
+}
+
+// AddListColumn adds a list column to the DataFrame. values must be a
+// [][]string, [][]int64, [][]float64, [][]bool or [][]time.Time holding the
+// list of each row.
+func (b *DataFrameBuilder) AddListColumn(name string, values any) *DataFrameBuilder {
+	col, err := newColumnSpec(name, values)
+	if err != nil || col.columnType != C.COLUMN_LIST {
+		return b
+	}
+	if err := b.validateColumnLength(col.length); err != nil {
+		return b
+	}
+
+	b.columns = append(b.columns, col)
+	return b
+}
+
 // validateColumnLength ensures all columns have the same length.
 func (b *DataFrameBuilder) validateColumnLength(length int) error {
 	if !b.hasRows {
@@ -858,6 +2162,9 @@ func (b *DataFrameBuilder) validateColumnLength(length int) error {
 
 // Build creates the DataFrame from the added columns.
 func (b *DataFrameBuilder) Build() (*DataFrame, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
 	if len(b.columns) == 0 {
 		return nil, errors.New("no columns added to builder")
 	}
@@ -874,83 +2181,7 @@ func (b *DataFrameBuilder) Build() (*DataFrame, error) {
 	}()
 
 	for i, col := range b.columns {
-		// Set column name
-		cName := C.CString(col.name)
-		managedMemory = append(managedMemory, unsafe.Pointer(cName))
-
-		cSpecs[i].name = cName
-		cSpecs[i].column_type = col.columnType
-		cSpecs[i].length = C.int(col.length)
-
-		// Handle data based on type
-		switch col.columnType {
-		case C.COLUMN_STRING:
-			values := col.data.([]string)
-			if len(values) == 0 {
-				cSpecs[i].data = nil
-			} else {
-				// Create array of C string pointers
-				cStringPtrs := (*C.char)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
-				managedMemory = append(managedMemory, unsafe.Pointer(cStringPtrs))
-
-				cStringArray := (*[1 << 30]*C.char)(unsafe.Pointer(cStringPtrs))[:len(values):len(values)]
-				for j, str := range values {
-					cStr := C.CString(str)
-					managedMemory = append(managedMemory, unsafe.Pointer(cStr))
-					cStringArray[j] = cStr
-				}
-				cSpecs[i].data = unsafe.Pointer(cStringPtrs)
-			}
-
-		case C.COLUMN_INT64:
-			values := col.data.([]int64)
-			if len(values) == 0 {
-				cSpecs[i].data = nil
-			} else {
-				cIntData := (*C.longlong)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.longlong(0)))))
-				managedMemory = append(managedMemory, unsafe.Pointer(cIntData))
-
-				cIntArray := (*[1 << 30]C.longlong)(unsafe.Pointer(cIntData))[:len(values):len(values)]
-				for j, val := range values {
-					cIntArray[j] = C.longlong(val)
-				}
-				cSpecs[i].data = unsafe.Pointer(cIntData)
-			}
-
-		case C.COLUMN_FLOAT64:
-			values := col.data.([]float64)
-			if len(values) == 0 {
-				cSpecs[i].data = nil
-			} else {
-				cFloatData := (*C.double)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.double(0)))))
-				managedMemory = append(managedMemory, unsafe.Pointer(cFloatData))
-
-				cFloatArray := (*[1 << 30]C.double)(unsafe.Pointer(cFloatData))[:len(values):len(values)]
-				for j, val := range values {
-					cFloatArray[j] = C.double(val)
-				}
-				cSpecs[i].data = unsafe.Pointer(cFloatData)
-			}
-
-		case C.COLUMN_BOOL:
-			values := col.data.([]bool)
-			if len(values) == 0 {
-				cSpecs[i].data = nil
-			} else {
-				cBoolData := (*C.uchar)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.uchar(0)))))
-				managedMemory = append(managedMemory, unsafe.Pointer(cBoolData))
-
-				cBoolArray := (*[1 << 30]C.uchar)(unsafe.Pointer(cBoolData))[:len(values):len(values)]
-				for j, val := range values {
-					if val {
-						cBoolArray[j] = 1
-					} else {
-						cBoolArray[j] = 0
-					}
-				}
-				cSpecs[i].data = unsafe.Pointer(cBoolData)
-			}
-		}
+		managedMemory = append(managedMemory, col.marshal(&cSpecs[i])...)
 	}
 
 	// Call the C function
@@ -960,15 +2191,17 @@ func (b *DataFrameBuilder) Build() (*DataFrame, error) {
 	)
 
 	if dfPtr == nil {
-		err := errors.New(C.GoString(C.get_last_error_message()))
+		err := errors.New(lastError())
 		return nil, fmt.Errorf("failed to create DataFrame: %w", err)
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(dfPtr)}, nil
+	return newDataFrame(dfPtr), nil
 }
 
 // SortBy sorts the DataFrame by one or more columns with specified sort orders.
 func (df *DataFrame) SortBy(columns []string, descending []bool) *DataFrame {
+	defer runtime.KeepAlive(df)
+
 	if df.ptr == nil {
 		log.Println("error: DataFrame is nil")
 		return &DataFrame{}
@@ -1009,15 +2242,18 @@ func (df *DataFrame) SortBy(columns []string, descending []bool) *DataFrame {
 
 	sortedPtr := C.sort_by_columns(df.ptr, cColumns, cDescending)
 	if sortedPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(sortedPtr)}
+	return newDataFrame(sortedPtr)
 }
 
 // SortByExprs sorts the DataFrame by expressions with specified sort orders.
 func (df *DataFrame) SortByExprs(exprs []Expr, descending []bool) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(exprs)
+
 	if df.ptr == nil {
 		log.Println("error: DataFrame is nil")
 		return &DataFrame{}
@@ -1030,7 +2266,7 @@ func (df *DataFrame) SortByExprs(exprs []Expr, descending []bool) *DataFrame {
 
 	cExprs := make([]*C.CExpr, len(exprs))
 	for i, expr := range exprs {
-		cExprs[i] = expr.ptr
+		cExprs[i] = expr.cptr()
 	}
 
 	cExprsPtr := (**C.CExpr)(unsafe.Pointer(&cExprs[0]))
@@ -1054,9 +2290,9 @@ func (df *DataFrame) SortByExprs(exprs []Expr, descending []bool) *DataFrame {
 
 	sortedPtr := C.sort_by_exprs(df.ptr, cExprsPtr, cExprsLen, cDescending)
 	if sortedPtr == nil {
-		log.Printf("error: %s", errors.New(C.GoString(C.get_last_error_message())))
+		log.Printf("error: %s", errors.New(lastError()))
 		return &DataFrame{}
 	}
 
-	return &DataFrame{ptr: (*C.CDataFrame)(sortedPtr)}
+	return newDataFrame(sortedPtr)
 }
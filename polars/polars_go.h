@@ -16,11 +16,32 @@ typedef struct CGroupBy {
   void* handle;
 } CGroupBy;
 
-extern CDataFrame* read_csv(const char* path);
+typedef struct CSeries {
+  void* handle;
+} CSeries;
+
 extern CDataFrame* read_parquet(const char* path);
+
+// Options for reading several files. A null or empty include_file_paths
+// adds no source file column.
+typedef struct {
+    uint8_t hive_partitioning;
+    const char* include_file_paths;
+} CScanOptions;
+
+extern CDataFrame* scan_parquet(const char* pattern, CScanOptions options);
 extern void free_dataframe(CDataFrame* df);
 extern const char* write_csv(CDataFrame* df, const char* path);
 extern const char* write_parquet(CDataFrame* df, const char* path);
+
+// Options for hive-partitioned Parquet writes. A max_rows_per_file of 0
+// writes a single file per partition.
+typedef struct {
+    size_t max_rows_per_file;
+    uint8_t keep_partition_columns;
+} CPartitionOptions;
+
+extern int write_parquet_partitioned(CDataFrame* df, const char* dir, const char** partition_by, int partition_by_len, CPartitionOptions options);
 extern size_t dataframe_width(const CDataFrame* df);
 extern size_t dataframe_height(const CDataFrame* df);
 extern const char* dataframe_column_name(const CDataFrame* df, size_t index);
@@ -40,12 +61,31 @@ extern CExpr* col_eq_f64(CExpr* expr, double value);
 extern CExpr* col_ne_f64(CExpr* expr, double value);
 extern CExpr* col_ge_f64(CExpr* expr, double value);
 extern CExpr* col_le_f64(CExpr* expr, double value);
+extern CExpr* expr_gt(CExpr* left_expr, CExpr* right_expr);
+extern CExpr* expr_lt(CExpr* left_expr, CExpr* right_expr);
+extern CExpr* expr_eq(CExpr* left_expr, CExpr* right_expr);
+extern CExpr* expr_ne(CExpr* left_expr, CExpr* right_expr);
+extern CExpr* expr_ge(CExpr* left_expr, CExpr* right_expr);
+extern CExpr* expr_le(CExpr* left_expr, CExpr* right_expr);
 extern CGroupBy* group_by(CDataFrame* df, const char* columns);
 extern const char* columns(CDataFrame* df);
 extern const char* print_dataframe(CDataFrame* df);
 extern const char* get_last_error_message();
+extern void free_c_string(char* s);
 extern void free_expr(CExpr* expr);
 extern void free_groupby(CGroupBy* groupby);
+extern size_t allocated_bytes();
+extern size_t dataframe_estimated_size(const CDataFrame* df);
+
+typedef struct {
+    size_t dataframes;
+    size_t exprs;
+    size_t groupbys;
+    size_t series;
+    size_t allocated_bytes;
+} CMemoryStats;
+
+extern CMemoryStats memory_stats();
 extern CExpr* expr_alias(CExpr* expr, const char* alias);
 extern CExpr* lit_int64(int64_t val);
 extern CExpr* lit_int32(int32_t val);
@@ -78,8 +118,45 @@ extern CExpr* expr_min(CExpr* expr);
 extern CExpr* expr_max(CExpr* expr);
 extern CExpr* expr_std(CExpr* expr);
 extern CExpr* expr_count();
+extern CExpr* expr_median(CExpr* expr);
+extern CExpr* expr_var(CExpr* expr);
+extern CExpr* expr_first(CExpr* expr);
+extern CExpr* expr_last(CExpr* expr);
+extern CExpr* expr_n_unique(CExpr* expr);
+extern CExpr* expr_null_count(CExpr* expr);
+extern CExpr* expr_unique(CExpr* expr);
+extern CExpr* expr_is_unique(CExpr* expr);
+extern CExpr* expr_is_first_distinct(CExpr* expr);
+extern CExpr* expr_implode(CExpr* expr);
+extern CExpr* expr_len(CExpr* expr);
+extern CGroupBy* group_by_stable(CDataFrame* df, const char** columns, int columns_len);
+extern CGroupBy* group_by_exprs(CDataFrame* df, CExpr** exprs, int exprs_len, uint8_t maintain_order);
 extern CDataFrame* sort_by_columns(CDataFrame* df, const char* columns, const char* descending);
 extern CDataFrame* sort_by_exprs(CDataFrame* df, CExpr** exprs, int exprs_len, const char* descending);
+extern CGroupBy* group_by_dynamic(CDataFrame* df, const char* index_column, const char* every, const char* period, const char* offset, const char** by, int by_len);
+extern CGroupBy* rolling(CDataFrame* df, const char* index_column, const char* period, const char** by, int by_len);
+extern CExpr* expr_rolling_sum(CExpr* expr, size_t window_size);
+extern CExpr* expr_rolling_mean(CExpr* expr, size_t window_size);
+extern CExpr* expr_rolling_min(CExpr* expr, size_t window_size);
+extern CExpr* expr_rolling_max(CExpr* expr, size_t window_size);
+extern CExpr* expr_rolling_std(CExpr* expr, size_t window_size);
+
+// String namespace
+extern CExpr* expr_str_split(CExpr* expr, const char* by);
+
+// List namespace
+extern CExpr* expr_list_len(CExpr* expr);
+extern CExpr* expr_list_get(CExpr* expr, int64_t index);
+extern CExpr* expr_list_contains(CExpr* expr, CExpr* item);
+extern CExpr* expr_list_join(CExpr* expr, const char* separator);
+extern CExpr* expr_list_sum(CExpr* expr);
+extern CExpr* expr_list_mean(CExpr* expr);
+extern CExpr* expr_list_sort(CExpr* expr, uint8_t descending);
+extern CExpr* expr_list_unique(CExpr* expr);
+
+// Struct namespace
+extern CExpr* expr_as_struct(CExpr** exprs, int exprs_len);
+extern CExpr* expr_struct_field(CExpr* expr, const char* name);
 
 // Column type enum for mixed DataFrame creation
 typedef enum {
@@ -87,28 +164,308 @@ typedef enum {
     COLUMN_INT64 = 1,
     COLUMN_FLOAT64 = 2,
     COLUMN_BOOL = 3,
+    COLUMN_DATETIME = 4,
+    COLUMN_LIST = 5,
+    COLUMN_DECIMAL = 6,
 } CColumnType;
 
-// Column specification for mixed DataFrame creation
+// Column specification for mixed DataFrame creation. For list columns, data
+// points to a CColumnSpec with the flattened values and offsets holds
+// length + 1 row boundaries into them. Decimal columns hold decimal strings
+// parsed with precision (0 to infer it) and scale.
 typedef struct {
     const char* name;
     CColumnType column_type;
     const void* data;
     int length;
+    const int64_t* offsets;
+    int precision;
+    int scale;
 } CColumnSpec;
 
 extern CDataFrame* create_dataframe_mixed(const CColumnSpec* column_specs, int column_count);
 
+// Data type enum shared by Series and casts
+typedef enum {
+    DTYPE_UNKNOWN = 0,
+    DTYPE_BOOLEAN = 1,
+    DTYPE_INT8 = 2,
+    DTYPE_INT16 = 3,
+    DTYPE_INT32 = 4,
+    DTYPE_INT64 = 5,
+    DTYPE_UINT8 = 6,
+    DTYPE_UINT16 = 7,
+    DTYPE_UINT32 = 8,
+    DTYPE_UINT64 = 9,
+    DTYPE_FLOAT32 = 10,
+    DTYPE_FLOAT64 = 11,
+    DTYPE_STRING = 12,
+    DTYPE_DATE = 13,
+    DTYPE_DATETIME = 14,
+    DTYPE_LIST = 15,
+    DTYPE_STRUCT = 16,
+    DTYPE_CATEGORICAL = 17,
+    DTYPE_ENUM = 18,
+    DTYPE_DECIMAL = 19,
+} CDataTypeId;
+
+// inner is the type of the values of a list and DTYPE_UNKNOWN otherwise.
+// categories lists the values of an enum; it is only read by casts and is
+// always null in data types returned by Rust. precision (0 to infer it) and
+// scale describe a decimal. For a list, categories, precision and scale
+// describe its inner type.
+typedef struct {
+    CDataTypeId id;
+    CDataTypeId inner;
+    const char** categories;
+    size_t categories_len;
+    size_t precision;
+    size_t scale;
+} CDataType;
+
+extern CExpr* expr_cast(CExpr* expr, CDataType dtype);
+
+// Categorical namespace
+extern CExpr* expr_cat_get_categories(CExpr* expr);
+
+// Columns named in override_names are read as the matching override_dtypes
+extern CDataFrame* read_csv(const char* path, const char** override_names, const CDataType* override_dtypes, int overrides_len, int infer_decimals);
+extern CDataFrame* read_csv_files(const char** paths, int paths_len, CScanOptions options, const char** override_names, const CDataType* override_dtypes, int overrides_len, int infer_decimals);
+
+// Value type enum for single values read from a Series
+typedef enum {
+    VALUE_NULL = 0,
+    VALUE_BOOL = 1,
+    VALUE_INT64 = 2,
+    VALUE_UINT64 = 3,
+    VALUE_FLOAT64 = 4,
+    VALUE_STRING = 5,
+    VALUE_DATE = 6,
+    VALUE_DATETIME = 7,
+    VALUE_LIST = 8,
+    VALUE_STRUCT = 9,
+    VALUE_DECIMAL = 10,
+} CValueType;
+
+// A single value. string_value and name are owned by the caller and released
+// with free_c_string. list_values is released with free_value_list once its
+// elements have been converted. Structs hold their fields in list_values,
+// each named by name.
+// Decimals are an int128 mantissa, int_value holding the high and uint_value
+// the low 64 bits, divided by 10^scale.
+typedef struct CValue {
+    CValueType value_type;
+    int64_t int_value;
+    uint64_t uint_value;
+    double float_value;
+    char* string_value;
+    struct CValue* list_values;
+    size_t list_len;
+    char* name;
+    size_t scale;
+} CValue;
+
+extern void free_value_list(CValue* values, size_t len);
+
+// Series functions
+extern CSeries* create_series(const CColumnSpec* spec);
+extern void free_series(CSeries* series);
+extern const char* series_name(const CSeries* series);
+extern size_t series_len(const CSeries* series);
+extern CDataType series_dtype(const CSeries* series);
+extern int series_get(const CSeries* series, size_t index, CValue* out);
+extern CSeries* series_slice(const CSeries* series, int64_t offset, size_t length);
+extern CSeries* series_add(const CSeries* left, const CSeries* right);
+extern CSeries* series_sub(const CSeries* left, const CSeries* right);
+extern CSeries* series_mul(const CSeries* left, const CSeries* right);
+extern CSeries* series_div(const CSeries* left, const CSeries* right);
+extern int series_sum(const CSeries* series, CValue* out);
+extern double series_mean(const CSeries* series);
+extern CSeries* series_unique(const CSeries* series);
+extern CDataFrame* series_value_counts(const CSeries* series);
+extern CSeries* series_sort(const CSeries* series, uint8_t descending);
+extern CSeries* series_cast(const CSeries* series, CDataType dtype);
+extern CSeries* dataframe_column(const CDataFrame* df, const char* name);
+extern CDataFrame* dataframe_with_series(const CDataFrame* df, const CSeries* series);
+extern CDataFrame* dataframe_hstack(const CDataFrame* df, const CSeries** series, int series_len);
+extern int64_t dataframe_rows(const CDataFrame* df, size_t offset, size_t length, CValue* out);
+
+// Quantile interpolation enum
+typedef enum {
+    QUANTILE_NEAREST = 0,
+    QUANTILE_LOWER = 1,
+    QUANTILE_HIGHER = 2,
+    QUANTILE_MIDPOINT = 3,
+    QUANTILE_LINEAR = 4,
+} CQuantileMethod;
+
+extern CExpr* expr_quantile(CExpr* expr, double quantile, CQuantileMethod method);
+
 // Join type enum
 typedef enum {
     JOIN_INNER = 0,
     JOIN_LEFT = 1,
     JOIN_RIGHT = 2,
     JOIN_OUTER = 3,
+    JOIN_SEMI = 4,
+    JOIN_ANTI = 5,
+    JOIN_CROSS = 6,
 } CJoinType;
 
 // Join functions
 extern CDataFrame* join_dataframes(CDataFrame* left_df, CDataFrame* right_df, const char* left_on, const char* right_on, CJoinType join_type);
 extern CDataFrame* join_dataframes_multiple_keys(CDataFrame* left_df, CDataFrame* right_df, const char* left_on, const char* right_on, CJoinType join_type);
 
+// Join validation enum
+typedef enum {
+    JOIN_VALIDATE_MANY_TO_MANY = 0,
+    JOIN_VALIDATE_ONE_TO_ONE = 1,
+    JOIN_VALIDATE_ONE_TO_MANY = 2,
+    JOIN_VALIDATE_MANY_TO_ONE = 3,
+} CJoinValidation;
+
+// Join coalesce enum
+typedef enum {
+    JOIN_COALESCE_DEFAULT = 0,
+    JOIN_COALESCE_COALESCE = 1,
+    JOIN_COALESCE_KEEP = 2,
+} CJoinCoalesce;
+
+// Join options. A null or empty suffix defaults to "_right".
+typedef struct {
+    const char* suffix;
+    CJoinValidation validation;
+    CJoinCoalesce coalesce;
+    uint8_t nulls_equal;
+} CJoinOptions;
+
+// As-of join strategy enum
+typedef enum {
+    ASOF_BACKWARD = 0,
+    ASOF_FORWARD = 1,
+    ASOF_NEAREST = 2,
+} CAsofStrategy;
+
+extern CDataFrame* join_asof(CDataFrame* left_df, CDataFrame* right_df, const char* left_on, const char* right_on, CAsofStrategy strategy, const char** by, int by_len, const char* tolerance);
+extern CDataFrame* join_where(CDataFrame* left_df, CDataFrame* right_df, CExpr** predicates, int predicates_len);
+extern CDataFrame* join_dataframes_exprs(CDataFrame* left_df, CDataFrame* right_df, CExpr** left_on, int left_on_len, CExpr** right_on, int right_on_len, CJoinType join_type, CJoinOptions options);
+
+// Concatenation method enum
+typedef enum {
+    CONCAT_VERTICAL = 0,
+    CONCAT_VERTICAL_RELAXED = 1,
+    CONCAT_DIAGONAL = 2,
+    CONCAT_HORIZONTAL = 3,
+} CConcatMethod;
+
+extern CDataFrame* concat_dataframes(CDataFrame** dfs, int dfs_len, CConcatMethod how);
+
+// Which row of each group of duplicates is kept
+typedef enum {
+    UNIQUE_KEEP_FIRST = 0,
+    UNIQUE_KEEP_LAST = 1,
+    UNIQUE_KEEP_NONE = 2,
+    UNIQUE_KEEP_ANY = 3,
+} CUniqueKeep;
+
+extern CDataFrame* dataframe_unique(CDataFrame* df, const char** subset, int subset_len, CUniqueKeep keep, uint8_t maintain_order);
+extern CSeries* dataframe_is_duplicated(const CDataFrame* df);
+extern int64_t dataframe_n_unique(const CDataFrame* df);
+
+// Aggregation applied to the values of each pivot cell
+typedef enum {
+    PIVOT_FIRST = 0,
+    PIVOT_LAST = 1,
+    PIVOT_SUM = 2,
+    PIVOT_MEAN = 3,
+    PIVOT_MEDIAN = 4,
+    PIVOT_MIN = 5,
+    PIVOT_MAX = 6,
+    PIVOT_LEN = 7,
+} CPivotAgg;
+
+extern CDataFrame* dataframe_pivot(CDataFrame* df, const char** on, int on_len, const char** index, int index_len, const char** values, int values_len, CPivotAgg agg);
+extern CDataFrame* dataframe_unpivot(CDataFrame* df, const char** index, int index_len, const char** on, int on_len, const char* variable_name, const char* value_name);
+
+extern CDataFrame* dataframe_explode(CDataFrame* df, const char** columns, int columns_len);
+
+extern CDataFrame* dataframe_unnest(CDataFrame* df, const char** columns, int columns_len);
+
+// String cache
+extern void string_cache_enable();
+extern void string_cache_disable();
+extern uint8_t string_cache_enabled();
+extern CSeries* series_get_categories(const CSeries* series);
+
+// Descriptive statistics
+typedef enum {
+    CORR_PEARSON = 0,
+    CORR_SPEARMAN = 1,
+} CCorrMethod;
+
+extern CExpr* expr_corr(CExpr* a, CExpr* b, CCorrMethod method);
+extern CExpr* expr_cov(CExpr* a, CExpr* b);
+extern CDataFrame* dataframe_describe(const CDataFrame* df, const double* percentiles, size_t percentiles_len);
+extern CDataFrame* dataframe_corr(const CDataFrame* df);
+
+// Row selection
+typedef struct {
+    uint8_t with_replacement;
+    uint8_t shuffle;
+    uint8_t has_seed;
+    uint64_t seed;
+} CSampleOptions;
+
+extern CDataFrame* dataframe_tail(const CDataFrame* df, size_t n);
+extern CDataFrame* dataframe_slice(const CDataFrame* df, int64_t offset, size_t length);
+extern CDataFrame* dataframe_sample_n(const CDataFrame* df, size_t n, CSampleOptions options);
+extern CDataFrame* dataframe_sample_frac(const CDataFrame* df, double fraction, CSampleOptions options);
+extern CDataFrame* dataframe_gather(const CDataFrame* df, const uint32_t* indices, size_t indices_len);
+extern CDataFrame* dataframe_gather_every(const CDataFrame* df, size_t n, size_t offset);
+extern CExpr* expr_head(CExpr* expr, size_t n);
+extern CExpr* expr_tail(CExpr* expr, size_t n);
+extern CExpr* expr_sample_n(CExpr* expr, size_t n, CSampleOptions options);
+extern CExpr* expr_sample_frac(CExpr* expr, double fraction, CSampleOptions options);
+
+// Column management
+extern CDataFrame* dataframe_drop(const CDataFrame* df, const char** columns, int columns_len);
+extern CDataFrame* dataframe_rename(const CDataFrame* df, const char** existing, const char** new_names, int len);
+extern CDataFrame* dataframe_with_row_index(const CDataFrame* df, const char* name, uint32_t offset);
+extern CDataFrame* dataframe_reorder(const CDataFrame* df, const char** columns, int columns_len);
+extern CExpr* expr_all();
+extern CExpr* expr_exclude(CExpr* expr, const char** columns, int columns_len);
+
+// Selectors
+extern CExpr* selector_by_dtype(const CDataType* dtypes, size_t dtypes_len);
+extern CExpr* selector_union(CExpr* a, CExpr* b);
+extern CExpr* selector_intersection(CExpr* a, CExpr* b);
+extern CExpr* selector_difference(CExpr* a, CExpr* b);
+
+// Math
+typedef enum {
+    TRIG_SIN = 0,
+    TRIG_COS = 1,
+    TRIG_TAN = 2,
+    TRIG_ARCSIN = 3,
+    TRIG_ARCCOS = 4,
+    TRIG_ARCTAN = 5,
+    TRIG_SINH = 6,
+    TRIG_COSH = 7,
+    TRIG_TANH = 8,
+} CTrigFunction;
+
+extern CExpr* expr_abs(CExpr* expr);
+extern CExpr* expr_round(CExpr* expr, uint32_t decimals);
+extern CExpr* expr_floor(CExpr* expr);
+extern CExpr* expr_ceil(CExpr* expr);
+extern CExpr* expr_sqrt(CExpr* expr);
+extern CExpr* expr_log(CExpr* expr, double base);
+extern CExpr* expr_exp(CExpr* expr);
+extern CExpr* expr_sign(CExpr* expr);
+extern CExpr* expr_pow(CExpr* left, CExpr* right);
+extern CExpr* expr_mod(CExpr* left, CExpr* right);
+extern CExpr* expr_floor_div(CExpr* left, CExpr* right);
+extern CExpr* expr_clip(CExpr* expr, CExpr* lower, CExpr* upper);
+extern CExpr* expr_trig(CExpr* expr, CTrigFunction function);
+
 #endif
@@ -0,0 +1,103 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"log"
+	"runtime"
+	"unsafe"
+)
+
+// rowBatchSize is the number of rows copied out of Rust per call while iterating.
+const rowBatchSize = 1024
+
+// Row returns the values of row i, in column order. Values are converted as
+// described for Series.Get.
+func (df *DataFrame) Row(i int) ([]any, error) {
+	if i < 0 || i >= df.Height() {
+		return nil, fmt.Errorf("row index %d out of bounds for DataFrame with %d rows", i, df.Height())
+	}
+
+	rows, err := df.readRows(i, 1)
+	if err != nil {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// Rows returns an iterator over the rows of the DataFrame. Rows are fetched
+// from Rust in batches, so iterating costs one FFI call per batch rather than
+// one per cell.
+func (df *DataFrame) Rows() iter.Seq[[]any] {
+	return func(yield func([]any) bool) {
+		for offset := 0; ; offset += rowBatchSize {
+			rows, err := df.readRows(offset, rowBatchSize)
+			if err != nil {
+				log.Printf("Error reading rows: %s", err)
+				return
+			}
+			for _, row := range rows {
+				if !yield(row) {
+					return
+				}
+			}
+			if len(rows) < rowBatchSize {
+				return
+			}
+		}
+	}
+}
+
+// IterRowsMap returns an iterator over the rows of the DataFrame as maps
+// from column name to value.
+func (df *DataFrame) IterRowsMap() iter.Seq[map[string]any] {
+	return func(yield func(map[string]any) bool) {
+		columns := df.Columns()
+		for row := range df.Rows() {
+			m := make(map[string]any, len(columns))
+			for i, name := range columns {
+				m[name] = row[i]
+			}
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// readRows copies up to length rows starting at offset out of Rust.
+func (df *DataFrame) readRows(offset, length int) ([][]any, error) {
+	defer runtime.KeepAlive(df)
+
+	width := df.Width()
+	if width == 0 || length <= 0 {
+		return nil, nil
+	}
+
+	cValues := (*C.CValue)(C.malloc(C.size_t(length*width) * C.size_t(unsafe.Sizeof(C.CValue{}))))
+	defer C.free(unsafe.Pointer(cValues))
+
+	n := int(C.dataframe_rows(df.ptr, C.size_t(offset), C.size_t(length), cValues))
+	if n < 0 {
+		return nil, errors.New(lastError())
+	}
+
+	values := unsafe.Slice(cValues, n*width)
+	rows := make([][]any, n)
+	for i := range rows {
+		row := make([]any, width)
+		for j := range row {
+			row[j] = goValue(&values[i*width+j])
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
@@ -0,0 +1,210 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"log"
+	"math"
+	"runtime"
+)
+
+// SampleOptions configures Sample and SampleFrac.
+type SampleOptions struct {
+	// WithReplacement allows a row to be drawn more than once.
+	WithReplacement bool
+	// Shuffle returns the sampled rows in random order instead of their
+	// original order.
+	Shuffle bool
+	// Seed makes sampling reproducible. A nil Seed samples differently on
+	// every call.
+	Seed *uint64
+}
+
+func sampleOptions(opts []SampleOptions) C.CSampleOptions {
+	var cOpts C.CSampleOptions
+	if len(opts) == 0 {
+		return cOpts
+	}
+	if opts[0].WithReplacement {
+		cOpts.with_replacement = 1
+	}
+	if opts[0].Shuffle {
+		cOpts.shuffle = 1
+	}
+	if opts[0].Seed != nil {
+		cOpts.has_seed = 1
+		cOpts.seed = C.uint64_t(*opts[0].Seed)
+	}
+	return cOpts
+}
+
+func wrapDataFrame(ptr *C.CDataFrame, action string) *DataFrame {
+	if ptr == nil {
+		err := lastError()
+		log.Printf("Error %s: %s", action, err)
+		return &DataFrame{}
+	}
+	return newDataFrame(ptr)
+}
+
+// Tail returns the last n rows of the DataFrame.
+func (df *DataFrame) Tail(n int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if n < 0 {
+		log.Printf("error: negative number of rows %d", n)
+		return &DataFrame{}
+	}
+
+	return wrapDataFrame(C.dataframe_tail(df.ptr, C.size_t(n)), "getting tail")
+}
+
+// Slice returns length rows starting at offset. A negative offset counts
+// from the end of the DataFrame.
+func (df *DataFrame) Slice(offset, length int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if length < 0 {
+		log.Printf("error: negative slice length %d", length)
+		return &DataFrame{}
+	}
+
+	return wrapDataFrame(C.dataframe_slice(df.ptr, C.int64_t(offset), C.size_t(length)), "slicing")
+}
+
+// Sample returns n randomly drawn rows. Without replacement, n must not
+// exceed the number of rows.
+func (df *DataFrame) Sample(n int, opts ...SampleOptions) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if n < 0 {
+		log.Printf("error: negative number of rows %d", n)
+		return &DataFrame{}
+	}
+
+	return wrapDataFrame(C.dataframe_sample_n(df.ptr, C.size_t(n), sampleOptions(opts)), "sampling")
+}
+
+// SampleFrac returns a fraction of the rows, randomly drawn.
+func (df *DataFrame) SampleFrac(fraction float64, opts ...SampleOptions) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if fraction < 0 {
+		log.Printf("error: negative sample fraction %g", fraction)
+		return &DataFrame{}
+	}
+
+	return wrapDataFrame(C.dataframe_sample_frac(df.ptr, C.double(fraction), sampleOptions(opts)), "sampling")
+}
+
+// Gather returns the rows at the given indices, in that order. Indices may
+// repeat.
+func (df *DataFrame) Gather(indices []int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cIndices := make([]C.uint32_t, len(indices))
+	for i, idx := range indices {
+		if idx < 0 || idx > math.MaxUint32 {
+			log.Printf("error: index %d out of bounds", idx)
+			return &DataFrame{}
+		}
+		cIndices[i] = C.uint32_t(idx)
+	}
+
+	var cIndicesPtr *C.uint32_t
+	if len(cIndices) > 0 {
+		cIndicesPtr = &cIndices[0]
+	}
+
+	return wrapDataFrame(C.dataframe_gather(df.ptr, cIndicesPtr, C.size_t(len(cIndices))), "gathering rows")
+}
+
+// GatherEvery returns every nth row, starting at row offset.
+func (df *DataFrame) GatherEvery(n, offset int) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+	if n <= 0 || offset < 0 {
+		log.Printf("error: invalid gather every arguments n=%d offset=%d", n, offset)
+		return &DataFrame{}
+	}
+
+	return wrapDataFrame(C.dataframe_gather_every(df.ptr, C.size_t(n), C.size_t(offset)), "gathering rows")
+}
+
+// Head creates an expression with the first n values.
+func (e Expr) Head(n int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if n < 0 {
+		log.Printf("error: negative number of values %d", n)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_head(e.cptr(), C.size_t(n)))
+}
+
+// Tail creates an expression with the last n values.
+func (e Expr) Tail(n int) Expr {
+	defer runtime.KeepAlive(e)
+
+	if n < 0 {
+		log.Printf("error: negative number of values %d", n)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_tail(e.cptr(), C.size_t(n)))
+}
+
+// Sample creates an expression with n randomly drawn values.
+func (e Expr) Sample(n int, opts ...SampleOptions) Expr {
+	defer runtime.KeepAlive(e)
+
+	if n < 0 {
+		log.Printf("error: negative number of values %d", n)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_sample_n(e.cptr(), C.size_t(n), sampleOptions(opts)))
+}
+
+// SampleFrac creates an expression with a fraction of the values, randomly
+// drawn.
+func (e Expr) SampleFrac(fraction float64, opts ...SampleOptions) Expr {
+	defer runtime.KeepAlive(e)
+
+	if fraction < 0 {
+		log.Printf("error: negative sample fraction %g", fraction)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_sample_frac(e.cptr(), C.double(fraction), sampleOptions(opts)))
+}
@@ -0,0 +1,55 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"runtime"
+)
+
+// DtypeCols creates an expression selecting every column of one of the given
+// types. The cs package builds on it for richer column selectors.
+func DtypeCols(dtypes ...DataType) Expr {
+	if len(dtypes) == 0 {
+		return Expr{}
+	}
+
+	cDtypes := make([]C.CDataType, len(dtypes))
+	for i, dtype := range dtypes {
+		cDtype, freeDtype := dtype.toC()
+		defer freeDtype()
+		cDtypes[i] = cDtype
+	}
+
+	return newExpr(C.selector_by_dtype(&cDtypes[0], C.size_t(len(cDtypes))))
+}
+
+// Union creates an expression selecting the columns selected by either
+// multi-column expression, such as All, DtypeCols or a regular expression Col.
+func (e Expr) Union(other Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.selector_union(e.cptr(), other.cptr()))
+}
+
+// Intersection creates an expression selecting the columns selected by both
+// multi-column expressions.
+func (e Expr) Intersection(other Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.selector_intersection(e.cptr(), other.cptr()))
+}
+
+// Difference creates an expression selecting the columns selected by e but
+// not by other.
+func (e Expr) Difference(other Expr) Expr {
+	defer runtime.KeepAlive(e)
+	defer runtime.KeepAlive(other)
+
+	return newExpr(C.selector_difference(e.cptr(), other.cptr()))
+}
@@ -0,0 +1,468 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"runtime"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// Series represents a single named Polars column.
+// Its memory is released by the garbage collector once the Series is
+// unreachable; call Free to release it earlier.
+type Series struct {
+	ptr *C.CSeries
+}
+
+// DataType identifies the type of the values held by a Series.
+// DataType values are comparable with ==.
+type DataType struct {
+	id    C.CDataTypeId
+	inner C.CDataTypeId
+	// categories holds the categories of an Enum, separated by NUL bytes so
+	// that DataType stays comparable.
+	categories string
+	// precision and scale describe a Decimal.
+	precision, scale int
+}
+
+// Data types supported by Series and Cast.
+var (
+	Boolean  = DataType{id: C.DTYPE_BOOLEAN}
+	Int8     = DataType{id: C.DTYPE_INT8}
+	Int16    = DataType{id: C.DTYPE_INT16}
+	Int32    = DataType{id: C.DTYPE_INT32}
+	Int64    = DataType{id: C.DTYPE_INT64}
+	UInt8    = DataType{id: C.DTYPE_UINT8}
+	UInt16   = DataType{id: C.DTYPE_UINT16}
+	UInt32   = DataType{id: C.DTYPE_UINT32}
+	UInt64   = DataType{id: C.DTYPE_UINT64}
+	Float32  = DataType{id: C.DTYPE_FLOAT32}
+	Float64  = DataType{id: C.DTYPE_FLOAT64}
+	String   = DataType{id: C.DTYPE_STRING}
+	Date     = DataType{id: C.DTYPE_DATE}
+	Datetime = DataType{id: C.DTYPE_DATETIME}
+	// Struct is the type of struct values, whatever their fields.
+	Struct = DataType{id: C.DTYPE_STRUCT}
+	// Categorical is the type of strings stored as codes into categories
+	// that grow as new values are seen. See Enum for a fixed set.
+	Categorical = DataType{id: C.DTYPE_CATEGORICAL}
+)
+
+// List returns the data type of lists holding values of type inner. The
+// precision, scale and categories of a List describe its inner type. Lists of
+// lists are not supported.
+func List(inner DataType) DataType {
+	return DataType{
+		id:         C.DTYPE_LIST,
+		inner:      inner.id,
+		categories: inner.categories,
+		precision:  inner.precision,
+		scale:      inner.scale,
+	}
+}
+
+// innerType returns the type of the values of a List.
+func (dt DataType) innerType() DataType {
+	return DataType{id: dt.inner, categories: dt.categories, precision: dt.precision, scale: dt.scale}
+}
+
+var dataTypeNames = map[C.CDataTypeId]string{
+	C.DTYPE_BOOLEAN:     "Boolean",
+	C.DTYPE_INT8:        "Int8",
+	C.DTYPE_INT16:       "Int16",
+	C.DTYPE_INT32:       "Int32",
+	C.DTYPE_INT64:       "Int64",
+	C.DTYPE_UINT8:       "UInt8",
+	C.DTYPE_UINT16:      "UInt16",
+	C.DTYPE_UINT32:      "UInt32",
+	C.DTYPE_UINT64:      "UInt64",
+	C.DTYPE_FLOAT32:     "Float32",
+	C.DTYPE_FLOAT64:     "Float64",
+	C.DTYPE_STRING:      "String",
+	C.DTYPE_DATE:        "Date",
+	C.DTYPE_DATETIME:    "Datetime",
+	C.DTYPE_STRUCT:      "Struct",
+	C.DTYPE_CATEGORICAL: "Categorical",
+	C.DTYPE_ENUM:        "Enum",
+}
+
+// String returns the Polars name of the data type.
+func (dt DataType) String() string {
+	switch dt.id {
+	case C.DTYPE_LIST:
+		return fmt.Sprintf("List(%s)", dt.innerType())
+	case C.DTYPE_DECIMAL:
+		return fmt.Sprintf("Decimal(%d, %d)", dt.precision, dt.scale)
+	}
+	if name, ok := dataTypeNames[dt.id]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// toC converts the data type for Rust. The returned function releases the
+// categories of an Enum, or of a List of Enum, and must be called once the
+// data type has been used.
+func (dt DataType) toC() (C.CDataType, func()) {
+	cDtype := C.CDataType{
+		id:        dt.id,
+		inner:     dt.inner,
+		precision: C.size_t(dt.precision),
+		scale:     C.size_t(dt.scale),
+	}
+	if dt.categories == "" {
+		return cDtype, func() {}
+	}
+
+	categories := strings.Split(dt.categories, "\x00")
+	cCategories, freeCategories := cStringArray(categories)
+	cDtype.categories = cCategories
+	cDtype.categories_len = C.size_t(len(categories))
+	return cDtype, freeCategories
+}
+
+// newSeries wraps a Rust Series so it is freed when garbage collected.
+func newSeries(ptr *C.CSeries) *Series {
+	s := &Series{ptr: ptr}
+	runtime.SetFinalizer(s, (*Series).Free)
+	notifyRustAllocation()
+	return s
+}
+
+// NewSeries creates a Series from a Go slice. Supported slice types are
+// []string, []int64, []float64, []bool and []time.Time, and slices of those
+// for list Series, such as [][]string.
+func NewSeries(name string, values any) (*Series, error) {
+	col, err := newColumnSpec(name, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var cSpec C.CColumnSpec
+	managedMemory := col.marshal(&cSpec)
+	defer func() {
+		for _, ptr := range managedMemory {
+			C.free(ptr)
+		}
+	}()
+
+	seriesPtr := C.create_series(&cSpec)
+	if seriesPtr == nil {
+		err := errors.New(lastError())
+		return nil, fmt.Errorf("failed to create Series: %w", err)
+	}
+
+	return newSeries(seriesPtr), nil
+}
+
+// Free releases the memory held by the Series. It is safe to call more than once.
+func (s *Series) Free() {
+	if s.ptr != nil {
+		runtime.SetFinalizer(s, nil)
+		C.free_series(s.ptr)
+		s.ptr = nil
+	}
+}
+
+// Name returns the name of the Series.
+func (s *Series) Name() string {
+	defer runtime.KeepAlive(s)
+
+	cName := C.series_name(s.ptr)
+	if cName == nil {
+		return ""
+	}
+	return goString(cName)
+}
+
+// Len returns the number of values in the Series.
+func (s *Series) Len() int {
+	defer runtime.KeepAlive(s)
+
+	return int(C.series_len(s.ptr))
+}
+
+// Dtype returns the data type of the Series.
+func (s *Series) Dtype() DataType {
+	defer runtime.KeepAlive(s)
+
+	cDtype := C.series_dtype(s.ptr)
+	dt := DataType{
+		id:        cDtype.id,
+		inner:     cDtype.inner,
+		precision: int(cDtype.precision),
+		scale:     int(cDtype.scale),
+	}
+	if dt.id == C.DTYPE_ENUM || (dt.id == C.DTYPE_LIST && dt.inner == C.DTYPE_ENUM) {
+		dt.categories = strings.Join(s.categories(), "\x00")
+	}
+	return dt
+}
+
+// Get returns the value at index i. Nulls are returned as nil, integers as
+// int64 or uint64, floats as float64, dates and datetimes as UTC time.Time
+// values, decimals as exact *big.Rat values, lists as []any and structs as
+// map[string]any of converted values. Other types are returned as their
+// string representation. DecodeStruct copies struct values into Go structs.
+func (s *Series) Get(i int) (any, error) {
+	defer runtime.KeepAlive(s)
+
+	if i < 0 {
+		return nil, fmt.Errorf("index %d out of bounds", i)
+	}
+
+	var value C.CValue
+	if C.series_get(s.ptr, C.size_t(i), &value) != 0 {
+		return nil, errors.New(lastError())
+	}
+
+	return goValue(&value), nil
+}
+
+// Slice returns a Series with length values starting at offset. A negative
+// offset counts from the end of the Series.
+func (s *Series) Slice(offset, length int) *Series {
+	defer runtime.KeepAlive(s)
+
+	return wrapSeries(C.series_slice(s.ptr, C.int64_t(offset), C.size_t(length)), "slicing Series")
+}
+
+// Add returns the element-wise sum of two Series.
+func (s *Series) Add(other *Series) *Series {
+	defer runtime.KeepAlive(s)
+	defer runtime.KeepAlive(other)
+
+	if other == nil {
+		log.Println("error: other Series is nil")
+		return &Series{}
+	}
+
+	return wrapSeries(C.series_add(s.ptr, other.ptr), "adding Series")
+}
+
+// Sub returns the element-wise difference of two Series.
+func (s *Series) Sub(other *Series) *Series {
+	defer runtime.KeepAlive(s)
+	defer runtime.KeepAlive(other)
+
+	if other == nil {
+		log.Println("error: other Series is nil")
+		return &Series{}
+	}
+
+	return wrapSeries(C.series_sub(s.ptr, other.ptr), "subtracting Series")
+}
+
+// Mul returns the element-wise product of two Series.
+func (s *Series) Mul(other *Series) *Series {
+	defer runtime.KeepAlive(s)
+	defer runtime.KeepAlive(other)
+
+	if other == nil {
+		log.Println("error: other Series is nil")
+		return &Series{}
+	}
+
+	return wrapSeries(C.series_mul(s.ptr, other.ptr), "multiplying Series")
+}
+
+// Div returns the element-wise quotient of two Series.
+func (s *Series) Div(other *Series) *Series {
+	defer runtime.KeepAlive(s)
+	defer runtime.KeepAlive(other)
+
+	if other == nil {
+		log.Println("error: other Series is nil")
+		return &Series{}
+	}
+
+	return wrapSeries(C.series_div(s.ptr, other.ptr), "dividing Series")
+}
+
+// Sum returns the sum of the values in the Series, ignoring nulls. The sum is
+// converted like Get: int64 for signed integers, uint64 for unsigned integers
+// and booleans, float64 for floats and *big.Rat for decimals.
+func (s *Series) Sum() (any, error) {
+	defer runtime.KeepAlive(s)
+
+	var value C.CValue
+	if C.series_sum(s.ptr, &value) != 0 {
+		return nil, errors.New(lastError())
+	}
+
+	return goValue(&value), nil
+}
+
+// Mean returns the mean of the values in the Series, ignoring nulls.
+// It returns NaN for empty or non-numeric Series.
+func (s *Series) Mean() float64 {
+	defer runtime.KeepAlive(s)
+
+	return float64(C.series_mean(s.ptr))
+}
+
+// Unique returns the distinct values of the Series in order of first appearance.
+func (s *Series) Unique() *Series {
+	defer runtime.KeepAlive(s)
+
+	return wrapSeries(C.series_unique(s.ptr), "getting unique values")
+}
+
+// ValueCounts returns a DataFrame with each distinct value and the number of
+// times it occurs in a "count" column, most frequent first.
+func (s *Series) ValueCounts() *DataFrame {
+	defer runtime.KeepAlive(s)
+
+	dfPtr := C.series_value_counts(s.ptr)
+	if dfPtr == nil {
+		err := lastError()
+		log.Printf("Error counting values: %s", err)
+		return &DataFrame{}
+	}
+	return newDataFrame(dfPtr)
+}
+
+// Sort returns a sorted copy of the Series.
+func (s *Series) Sort(descending bool) *Series {
+	defer runtime.KeepAlive(s)
+
+	var cDescending C.uint8_t
+	if descending {
+		cDescending = 1
+	}
+	return wrapSeries(C.series_sort(s.ptr, cDescending), "sorting Series")
+}
+
+// Cast returns a copy of the Series converted to dtype. Values that cannot
+// be converted make the cast fail rather than becoming null.
+func (s *Series) Cast(dtype DataType) *Series {
+	defer runtime.KeepAlive(s)
+
+	cDtype, freeDtype := dtype.toC()
+	defer freeDtype()
+
+	return wrapSeries(C.series_cast(s.ptr, cDtype), "casting Series")
+}
+
+// Column returns the column with the given name as a Series.
+func (df *DataFrame) Column(name string) (*Series, error) {
+	defer runtime.KeepAlive(df)
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	seriesPtr := C.dataframe_column(df.ptr, cName)
+	if seriesPtr == nil {
+		return nil, errors.New(lastError())
+	}
+	return newSeries(seriesPtr), nil
+}
+
+// WithSeries returns a DataFrame with the Series added as a column, replacing
+// any existing column with the same name.
+func (df *DataFrame) WithSeries(s *Series) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(s)
+
+	dfPtr := C.dataframe_with_series(df.ptr, s.ptr)
+	if dfPtr == nil {
+		err := lastError()
+		log.Printf("Error adding Series: %s", err)
+		return &DataFrame{}
+	}
+	return newDataFrame(dfPtr)
+}
+
+// goValue converts a value returned by Rust into its Go representation,
+// releasing any string it owns.
+func goValue(value *C.CValue) any {
+	switch value.value_type {
+	case C.VALUE_BOOL:
+		return value.int_value != 0
+	case C.VALUE_INT64:
+		return int64(value.int_value)
+	case C.VALUE_UINT64:
+		return uint64(value.uint_value)
+	case C.VALUE_FLOAT64:
+		return float64(value.float_value)
+	case C.VALUE_STRING:
+		return goString(value.string_value)
+	case C.VALUE_DATE:
+		return time.Unix(int64(value.int_value)*24*60*60, 0).UTC()
+	case C.VALUE_DATETIME:
+		return time.UnixMicro(int64(value.int_value)).UTC()
+	case C.VALUE_DECIMAL:
+		return decimalValue(value)
+	case C.VALUE_LIST:
+		defer C.free_value_list(value.list_values, value.list_len)
+		elems := unsafe.Slice(value.list_values, int(value.list_len))
+		list := make([]any, len(elems))
+		for i := range elems {
+			list[i] = goValue(&elems[i])
+		}
+		return list
+	case C.VALUE_STRUCT:
+		defer C.free_value_list(value.list_values, value.list_len)
+		fields := unsafe.Slice(value.list_values, int(value.list_len))
+		m := make(map[string]any, len(fields))
+		for i := range fields {
+			name := goString(fields[i].name)
+			m[name] = goValue(&fields[i])
+		}
+		return m
+	default:
+		return nil
+	}
+}
+
+// HStack returns a DataFrame with the Series appended as new columns. Each
+// Series must have as many values as the DataFrame has rows and a name not
+// already used by a column.
+func (df *DataFrame) HStack(series ...*Series) *DataFrame {
+	defer runtime.KeepAlive(df)
+	defer runtime.KeepAlive(series)
+
+	cSeries := make([]*C.CSeries, len(series))
+	for i, s := range series {
+		if s == nil {
+			log.Printf("error: Series %d is nil", i)
+			return &DataFrame{}
+		}
+		cSeries[i] = s.ptr
+	}
+
+	var cSeriesPtr **C.CSeries
+	if len(cSeries) > 0 {
+		cSeriesPtr = &cSeries[0]
+	}
+
+	dfPtr := C.dataframe_hstack(df.ptr, cSeriesPtr, C.int(len(cSeries)))
+	if dfPtr == nil {
+		err := lastError()
+		log.Printf("Error stacking Series: %s", err)
+		return &DataFrame{}
+	}
+	return newDataFrame(dfPtr)
+}
+
+// wrapSeries wraps the result of a Series operation, logging the last error
+// and returning an empty Series when the operation failed.
+func wrapSeries(ptr *C.CSeries, action string) *Series {
+	if ptr == nil {
+		err := lastError()
+		log.Printf("Error %s: %s", action, err)
+		return &Series{}
+	}
+	return newSeries(ptr)
+}
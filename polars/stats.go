@@ -0,0 +1,101 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"log"
+	"runtime"
+)
+
+// CorrMethod selects how Corr measures the correlation of two columns.
+type CorrMethod string
+
+const (
+	// CorrPearson measures the linear correlation of the values.
+	CorrPearson CorrMethod = "pearson"
+	// CorrSpearman measures the Pearson correlation of the value ranks.
+	CorrSpearman CorrMethod = "spearman"
+)
+
+// Corr creates an expression with the correlation of a and b, between -1
+// and 1.
+func Corr(a, b Expr, method CorrMethod) Expr {
+	defer runtime.KeepAlive(a)
+	defer runtime.KeepAlive(b)
+
+	var cMethod C.CCorrMethod
+	switch method {
+	case CorrPearson:
+		cMethod = C.CORR_PEARSON
+	case CorrSpearman:
+		cMethod = C.CORR_SPEARMAN
+	default:
+		log.Printf("error: unknown correlation method %s", method)
+		return Expr{}
+	}
+
+	return newExpr(C.expr_corr(a.cptr(), b.cptr(), cMethod))
+}
+
+// Cov creates an expression with the sample covariance of a and b.
+func Cov(a, b Expr) Expr {
+	defer runtime.KeepAlive(a)
+	defer runtime.KeepAlive(b)
+
+	return newExpr(C.expr_cov(a.cptr(), b.cptr()))
+}
+
+// Describe returns summary statistics of every column: one row each for
+// count, null_count, mean, std, min, the given percentiles and max, labelled
+// by a leading "statistic" column. If the DataFrame already has a column of
+// that name, the label column gets underscores prepended until its name is
+// unique. Percentiles are between 0 and 1 and default to the quartiles.
+// Numeric columns are described as Float64 values; other columns as strings,
+// with only counts, min and max filled in.
+func (df *DataFrame) Describe(percentiles ...float64) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	if len(percentiles) == 0 {
+		percentiles = []float64{0.25, 0.5, 0.75}
+	}
+
+	describePtr := C.dataframe_describe(df.ptr, (*C.double)(&percentiles[0]), C.size_t(len(percentiles)))
+	if describePtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while describing DataFrame: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(describePtr)
+}
+
+// Corr returns the Pearson correlation matrix of the numeric columns, with
+// one column per numeric column and rows in the same order. Other columns
+// are skipped.
+func (df *DataFrame) Corr() *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	corrPtr := C.dataframe_corr(df.ptr)
+	if corrPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while computing correlation matrix: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(corrPtr)
+}
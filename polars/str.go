@@ -0,0 +1,34 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// StringNamespace groups the expressions operating on string values.
+type StringNamespace struct {
+	expr Expr
+}
+
+// Str returns the string namespace of the expression.
+func (e Expr) Str() StringNamespace {
+	return StringNamespace{expr: e}
+}
+
+// Split creates an expression splitting each string on by into a list of
+// substrings.
+func (s StringNamespace) Split(by string) Expr {
+	defer runtime.KeepAlive(s.expr)
+
+	cBy := C.CString(by)
+	defer C.free(unsafe.Pointer(cBy))
+
+	return newExpr(C.expr_str_split(s.expr.cptr(), cBy))
+}
@@ -0,0 +1,240 @@
+package polars
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#include "polars_go.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// StructNamespace groups the expressions operating on struct values.
+type StructNamespace struct {
+	expr Expr
+}
+
+// Struct returns the struct namespace of the expression.
+func (e Expr) Struct() StructNamespace {
+	return StructNamespace{expr: e}
+}
+
+// AsStruct creates an expression combining several expressions into a single
+// struct column, with one field per expression named after its output.
+func AsStruct(exprs ...Expr) Expr {
+	defer runtime.KeepAlive(exprs)
+
+	if len(exprs) == 0 {
+		return Expr{}
+	}
+
+	cExprs := make([]*C.CExpr, len(exprs))
+	for i, expr := range exprs {
+		cExprs[i] = expr.cptr()
+	}
+
+	return newExpr(C.expr_as_struct(&cExprs[0], C.int(len(cExprs))))
+}
+
+// Field creates an expression with the values of the named struct field.
+func (s StructNamespace) Field(name string) Expr {
+	defer runtime.KeepAlive(s.expr)
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return newExpr(C.expr_struct_field(s.expr.cptr(), cName))
+}
+
+// Unnest returns a DataFrame where each of the given struct columns is
+// replaced by one column per field.
+func (df *DataFrame) Unnest(columns ...string) *DataFrame {
+	defer runtime.KeepAlive(df)
+
+	if df == nil || df.ptr == nil {
+		log.Println("error: DataFrame is nil")
+		return &DataFrame{}
+	}
+
+	cColumns, freeColumns := cStringArray(columns)
+	defer freeColumns()
+
+	unnestedPtr := C.dataframe_unnest(df.ptr, cColumns, C.int(len(columns)))
+	if unnestedPtr == nil {
+		err := errors.New(lastError())
+		log.Printf("Error while unnesting: %s", err)
+		return &DataFrame{}
+	}
+
+	return newDataFrame(unnestedPtr)
+}
+
+// DecodeStruct copies a struct value, as returned by Series.Get or Row, into
+// the Go struct pointed to by dst. Fields are matched by their `polars` tag or
+// else by case-insensitive name, and fields tagged `polars:"-"` or without a
+// matching value are left unchanged. Nested structs and lists are decoded
+// into struct, pointer and slice fields, and numbers are converted to the
+// field's numeric type. A number that does not fit the field, or a float with
+// a fractional part decoded into an integer field, is an error.
+func DecodeStruct(value any, dst any) error {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected a struct value, got %T", value)
+	}
+
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	return decodeStruct(m, rv.Elem())
+}
+
+func decodeStruct(m map[string]any, dst reflect.Value) error {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("polars"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+
+		value, ok := lookupField(m, name)
+		if !ok {
+			continue
+		}
+		if err := decodeValue(value, dst.Field(i)); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// lookupField finds the value of the named field, preferring an exact match.
+func lookupField(m map[string]any, name string) (any, bool) {
+	if value, ok := m[name]; ok {
+		return value, true
+	}
+	for key, value := range m {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func decodeValue(value any, dst reflect.Value) error {
+	if value == nil {
+		dst.SetZero()
+		return nil
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		elem := reflect.New(dst.Type().Elem())
+		if err := decodeValue(value, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		if dst.Kind() == reflect.Struct {
+			return decodeStruct(v, dst)
+		}
+	case []any:
+		if dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() != reflect.Interface {
+			slice := reflect.MakeSlice(dst.Type(), len(v), len(v))
+			for i, elem := range v {
+				if err := decodeValue(elem, slice.Index(i)); err != nil {
+					return fmt.Errorf("index %d: %w", i, err)
+				}
+			}
+			dst.Set(slice)
+			return nil
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	if isNumeric(rv.Kind()) && isNumeric(dst.Kind()) {
+		return decodeNumber(rv, dst)
+	}
+
+	return fmt.Errorf("cannot decode %T into %s", value, dst.Type())
+}
+
+// decodeNumber converts a number to the field's numeric type, refusing values
+// that do not fit it or that would lose their fractional part.
+func decodeNumber(rv, dst reflect.Value) error {
+	var fits bool
+	switch {
+	case rv.CanInt():
+		v := rv.Int()
+		switch {
+		case dst.CanInt():
+			fits = !dst.OverflowInt(v)
+		case dst.CanUint():
+			fits = v >= 0 && !dst.OverflowUint(uint64(v))
+		default:
+			fits = true
+		}
+	case rv.CanUint():
+		v := rv.Uint()
+		switch {
+		case dst.CanInt():
+			fits = v <= math.MaxInt64 && !dst.OverflowInt(int64(v))
+		case dst.CanUint():
+			fits = !dst.OverflowUint(v)
+		default:
+			fits = true
+		}
+	default:
+		v := rv.Float()
+		switch {
+		case dst.CanInt():
+			fits = v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 && !dst.OverflowInt(int64(v))
+		case dst.CanUint():
+			fits = v == math.Trunc(v) && v >= 0 && v < math.MaxUint64 && !dst.OverflowUint(uint64(v))
+		default:
+			fits = !dst.OverflowFloat(v)
+		}
+	}
+	if !fits {
+		return fmt.Errorf("cannot decode %v into %s without losing data", rv.Interface(), dst.Type())
+	}
+
+	dst.Set(rv.Convert(dst.Type()))
+	return nil
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestCategorical(t *testing.T) {
+	countries, err := polars.NewSeries("country", []string{"fr", "de", "fr", "it"})
+	if err != nil {
+		t.Fatalf("Failed to create Series: %v", err)
+	}
+
+	cat := countries.Cast(polars.Categorical)
+	defer cat.Free()
+
+	if cat.Dtype() != polars.Categorical {
+		t.Fatalf("Expected Categorical, got %s", cat.Dtype())
+	}
+	if v, _ := cat.Get(2); v != "fr" {
+		t.Errorf("Expected categorical values to read as strings, got %v", v)
+	}
+
+	categories := cat.GetCategories()
+	defer categories.Free()
+	if categories.Len() != 3 {
+		t.Errorf("Expected 3 categories, got %d", categories.Len())
+	}
+
+	t.Run("ExprCast", func(t *testing.T) {
+		df, err := polars.NewDataFrame().
+			AddStringColumn("status", []string{"ok", "failed", "ok"}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer df.Free()
+
+		result := df.WithColumns(polars.Col("status").Cast(polars.Categorical))
+		defer result.Free()
+
+		status, err := result.Column("status")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		if status.Dtype() != polars.Categorical {
+			t.Errorf("Expected Categorical, got %s", status.Dtype())
+		}
+
+		cats := result.Select(polars.Col("status").Cat().GetCategories())
+		defer cats.Free()
+		if cats.Height() != 2 {
+			t.Errorf("Expected 2 categories, got %d", cats.Height())
+		}
+	})
+}
+
+func TestEnum(t *testing.T) {
+	levels := polars.Enum("low", "medium", "high")
+	if !reflect.DeepEqual(levels.Categories(), []string{"low", "medium", "high"}) {
+		t.Errorf("Unexpected categories: %v", levels.Categories())
+	}
+	if polars.String.Categories() != nil {
+		t.Error("Expected no categories for String")
+	}
+
+	values, _ := polars.NewSeries("level", []string{"high", "low"})
+	enum := values.Cast(levels)
+	defer enum.Free()
+
+	if enum.Dtype() != levels {
+		t.Errorf("Expected %s with categories %v, got %v", levels, levels.Categories(), enum.Dtype().Categories())
+	}
+
+	// Categories keep the declared order rather than order of appearance.
+	categories := enum.GetCategories()
+	defer categories.Free()
+	if first, _ := categories.Get(0); first != "low" {
+		t.Errorf("Expected first category low, got %v", first)
+	}
+
+	t.Run("UnknownValue", func(t *testing.T) {
+		bad, _ := polars.NewSeries("level", []string{"extreme"})
+		if result := bad.Cast(levels); result.Len() != 0 {
+			t.Error("Expected cast to fail for a value outside the categories")
+		}
+	})
+}
+
+func TestStringCache(t *testing.T) {
+	polars.EnableStringCache()
+	defer polars.DisableStringCache()
+
+	if !polars.StringCacheEnabled() {
+		t.Fatal("Expected string cache to be enabled")
+	}
+
+	left, err := polars.NewDataFrame().
+		AddStringColumn("country", []string{"fr", "de"}).
+		AddIntColumn("population", []int64{68, 84}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer left.Free()
+
+	right, err := polars.NewDataFrame().
+		AddStringColumn("country", []string{"de", "fr"}).
+		AddStringColumn("capital", []string{"Berlin", "Paris"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer right.Free()
+
+	leftCat := left.WithColumns(polars.Col("country").Cast(polars.Categorical))
+	defer leftCat.Free()
+	rightCat := right.WithColumns(polars.Col("country").Cast(polars.Categorical))
+	defer rightCat.Free()
+
+	joined := leftCat.Join(rightCat, "country", polars.JoinInner)
+	defer joined.Free()
+
+	if joined.Height() != 2 {
+		t.Fatalf("Expected 2 joined rows, got %d", joined.Height())
+	}
+	row, _ := joined.Row(0)
+	if row[0] != "fr" || row[2] != "Paris" {
+		t.Errorf("Unexpected joined row: %v", row)
+	}
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestColumnManagement(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("region", []string{"north", "south"}).
+		AddIntColumn("sales_2023", []int64{100, 200}).
+		AddIntColumn("sales_2024", []int64{150, 250}).
+		AddStringColumn("manager", []string{"Ann", "Bo"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("DropAndRename", func(t *testing.T) {
+		dropped := df.Drop("manager", "sales_2023")
+		defer dropped.Free()
+		assertColumns(t, dropped, "region", "sales_2024")
+
+		renamed := df.Rename(map[string]string{"region": "area", "manager": "owner"})
+		defer renamed.Free()
+		assertColumns(t, renamed, "area", "sales_2023", "sales_2024", "owner")
+
+		swapped := df.Rename(map[string]string{"sales_2023": "sales_2024", "sales_2024": "sales_2023"})
+		defer swapped.Free()
+		assertValues(t, columnValues(t, swapped, "sales_2023"), int64(150), int64(250))
+	})
+
+	t.Run("UnknownColumn", func(t *testing.T) {
+		if result := df.Drop("missing"); result.Width() != 0 {
+			t.Error("Expected empty result when dropping an unknown column")
+		}
+		if result := df.Rename(map[string]string{"missing": "x"}); result.Width() != 0 {
+			t.Error("Expected empty result when renaming an unknown column")
+		}
+		if result := df.Rename(map[string]string{"region": "manager"}); result.Width() != 0 {
+			t.Error("Expected empty result when renaming to an existing name")
+		}
+	})
+
+	t.Run("WithRowIndexAndReorder", func(t *testing.T) {
+		indexed := df.WithRowIndex("row", 1)
+		defer indexed.Free()
+		assertColumns(t, indexed, "row", "region", "sales_2023", "sales_2024", "manager")
+		assertValues(t, columnValues(t, indexed, "row"), uint64(1), uint64(2))
+
+		reordered := df.Reorder("manager", "sales_2024")
+		defer reordered.Free()
+		assertColumns(t, reordered, "manager", "sales_2024", "region", "sales_2023")
+	})
+
+	t.Run("AllExcludeRegex", func(t *testing.T) {
+		all := df.Select(polars.All())
+		defer all.Free()
+		assertColumns(t, all, "region", "sales_2023", "sales_2024", "manager")
+
+		excluded := df.Select(polars.Exclude("region", "manager"))
+		defer excluded.Free()
+		assertColumns(t, excluded, "sales_2023", "sales_2024")
+
+		sales := df.Select(polars.Col("^sales_.*$"))
+		defer sales.Free()
+		assertColumns(t, sales, "sales_2023", "sales_2024")
+
+		latest := df.Select(polars.Col("^sales_.*$").Exclude("sales_2023"))
+		defer latest.Free()
+		assertColumns(t, latest, "sales_2024")
+	})
+}
@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestConcat(t *testing.T) {
+	day1, err := polars.NewDataFrame().
+		AddStringColumn("host", []string{"a", "b"}).
+		AddIntColumn("requests", []int64{10, 20}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer day1.Free()
+
+	day2, err := polars.NewDataFrame().
+		AddStringColumn("host", []string{"a", "c", "d"}).
+		AddIntColumn("requests", []int64{30, 40, 50}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer day2.Free()
+
+	floats, err := polars.NewDataFrame().
+		AddStringColumn("host", []string{"e"}).
+		AddFloatColumn("requests", []float64{1.5}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer floats.Free()
+
+	t.Run("Vertical", func(t *testing.T) {
+		result := polars.Concat([]*polars.DataFrame{day1, day2}, polars.ConcatVertical)
+		defer result.Free()
+
+		if result.Height() != 5 || result.Width() != 2 {
+			t.Fatalf("Expected 5x2 DataFrame, got %dx%d", result.Height(), result.Width())
+		}
+
+		row, err := result.Row(2)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[0] != "a" || row[1] != int64(30) {
+			t.Errorf("Expected first row of second frame, got %v", row)
+		}
+	})
+
+	t.Run("VerticalSchemaMismatch", func(t *testing.T) {
+		result := polars.Concat([]*polars.DataFrame{day1, floats}, polars.ConcatVertical)
+		if result.Width() != 0 {
+			t.Error("Expected empty result for mismatched schemas")
+		}
+	})
+
+	t.Run("VerticalRelaxed", func(t *testing.T) {
+		result := polars.Concat([]*polars.DataFrame{day1, floats}, polars.ConcatVerticalRelaxed)
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Fatalf("Expected 3 rows, got %d", result.Height())
+		}
+
+		requests, err := result.Column("requests")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		if requests.Dtype() != polars.Float64 {
+			t.Errorf("Expected supertype Float64, got %s", requests.Dtype())
+		}
+	})
+
+	t.Run("Diagonal", func(t *testing.T) {
+		extra, err := polars.NewDataFrame().
+			AddStringColumn("host", []string{"z"}).
+			AddBoolColumn("healthy", []bool{true}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+
+		result := polars.Concat([]*polars.DataFrame{day1, extra}, polars.ConcatDiagonal)
+		defer result.Free()
+
+		if result.Height() != 3 || result.Width() != 3 {
+			t.Fatalf("Expected 3x3 DataFrame, got %dx%d", result.Height(), result.Width())
+		}
+
+		row, err := result.Row(2)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[0] != "z" || row[1] != nil || row[2] != true {
+			t.Errorf("Expected missing requests to be null, got %v", row)
+		}
+	})
+
+	t.Run("Horizontal", func(t *testing.T) {
+		scores, err := polars.NewDataFrame().
+			AddFloatColumn("score", []float64{0.5, 0.9}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+
+		result := polars.Concat([]*polars.DataFrame{day1, scores}, polars.ConcatHorizontal)
+		defer result.Free()
+
+		if result.Height() != 2 || result.Width() != 3 {
+			t.Errorf("Expected 2x3 DataFrame, got %dx%d", result.Height(), result.Width())
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		if result := polars.Concat(nil, polars.ConcatVertical); result.Width() != 0 {
+			t.Error("Expected empty result without frames")
+		}
+		if result := polars.Concat([]*polars.DataFrame{day1, nil}, polars.ConcatVertical); result.Width() != 0 {
+			t.Error("Expected empty result with a nil frame")
+		}
+		if result := polars.Concat([]*polars.DataFrame{day1}, "sideways"); result.Width() != 0 {
+			t.Error("Expected empty result for unknown method")
+		}
+	})
+}
+
+func TestHStack(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("name", []string{"Alice", "Bob"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	ages, _ := polars.NewSeries("age", []int64{30, 40})
+	active, _ := polars.NewSeries("active", []bool{true, false})
+
+	result := df.HStack(ages, active)
+	defer result.Free()
+
+	columns := result.Columns()
+	expected := []string{"name", "age", "active"}
+	if len(columns) != len(expected) {
+		t.Fatalf("Expected columns %v, got %v", expected, columns)
+	}
+	for i, name := range expected {
+		if columns[i] != name {
+			t.Errorf("Expected column %d to be %q, got %q", i, name, columns[i])
+		}
+	}
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		dup, _ := polars.NewSeries("name", []string{"x", "y"})
+		if result := df.HStack(dup); result.Width() != 0 {
+			t.Error("Expected empty result for duplicate column name")
+		}
+	})
+
+	t.Run("LengthMismatch", func(t *testing.T) {
+		short, _ := polars.NewSeries("short", []int64{1})
+		if result := df.HStack(short); result.Width() != 0 {
+			t.Error("Expected empty result for length mismatch")
+		}
+	})
+}
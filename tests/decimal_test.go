@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func rat(t *testing.T, s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		t.Fatalf("Invalid decimal %q", s)
+	}
+	return r
+}
+
+func TestDecimalBuilder(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("account", []string{"a", "b", "c"}).
+		AddDecimalColumn("balance", []*big.Rat{rat(t, "0.10"), rat(t, "-1234567890123.45"), nil}, 18, 2).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	balance, err := df.Column("balance")
+	if err != nil {
+		t.Fatalf("Failed to get column: %v", err)
+	}
+	if balance.Dtype() != polars.Decimal(18, 2) {
+		t.Errorf("Expected Decimal(18, 2), got %s", balance.Dtype())
+	}
+
+	for i, want := range []string{"0.10", "-1234567890123.45"} {
+		v, err := balance.Get(i)
+		if err != nil {
+			t.Fatalf("Failed to get value: %v", err)
+		}
+		got, ok := v.(*big.Rat)
+		if !ok || got.Cmp(rat(t, want)) != 0 {
+			t.Errorf("Expected %s at row %d, got %v", want, i, v)
+		}
+	}
+	if v, _ := balance.Get(2); v != nil {
+		t.Errorf("Expected nil for null decimal, got %v", v)
+	}
+
+	t.Run("InexactValue", func(t *testing.T) {
+		_, err := polars.NewDataFrame().
+			AddDecimalColumn("amount", []*big.Rat{rat(t, "0.10"), rat(t, "0.125")}, 10, 2).
+			Build()
+		if err == nil {
+			t.Error("Expected error for a value with more digits than the scale")
+		}
+
+		_, err = polars.NewDataFrame().
+			AddDecimalColumn("third", []*big.Rat{rat(t, "1/3")}, 10, 6).
+			Build()
+		if err == nil {
+			t.Error("Expected error for a value with no finite decimal expansion")
+		}
+	})
+
+	t.Run("ExactSum", func(t *testing.T) {
+		cents, err := polars.NewDataFrame().
+			AddDecimalColumn("amount", []*big.Rat{rat(t, "0.1"), rat(t, "0.2")}, 10, 2).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer cents.Free()
+
+		total := cents.Select(polars.Col("amount").Sum())
+		defer total.Free()
+
+		row, _ := total.Row(0)
+		if sum, ok := row[0].(*big.Rat); !ok || sum.Cmp(rat(t, "0.3")) != 0 {
+			t.Errorf("Expected exact sum 0.3, got %v", row[0])
+		}
+	})
+}
+
+func TestDecimalCast(t *testing.T) {
+	prices, _ := polars.NewSeries("price", []string{"19.99", "5.5"})
+	decimals := prices.Cast(polars.Decimal(10, 2))
+	defer decimals.Free()
+
+	if decimals.Dtype().String() != "Decimal(10, 2)" {
+		t.Errorf("Expected Decimal(10, 2), got %s", decimals.Dtype())
+	}
+	v, _ := decimals.Get(1)
+	if r, ok := v.(*big.Rat); !ok || r.FloatString(2) != "5.50" {
+		t.Errorf("Expected 5.50, got %v", v)
+	}
+}
+
+func TestReadCSVDecimalOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.csv")
+	content := "account,amount\na,100.01\nb,-0.07\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+
+	df, err := polars.ReadCSV(path, polars.CSVOptions{
+		SchemaOverrides: map[string]polars.DataType{"amount": polars.Decimal(18, 2)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+	defer df.Free()
+
+	amount, err := df.Column("amount")
+	if err != nil {
+		t.Fatalf("Failed to get column: %v", err)
+	}
+	if amount.Dtype() != polars.Decimal(18, 2) {
+		t.Fatalf("Expected Decimal(18, 2), got %s", amount.Dtype())
+	}
+	v, _ := amount.Get(1)
+	if r, ok := v.(*big.Rat); !ok || r.Cmp(rat(t, "-0.07")) != 0 {
+		t.Errorf("Expected -0.07, got %v", v)
+	}
+
+	t.Run("MultipleFiles", func(t *testing.T) {
+		second := filepath.Join(t.TempDir(), "ledger2.csv")
+		if err := os.WriteFile(second, []byte("account,amount\nc,0.30\n"), 0o644); err != nil {
+			t.Fatalf("Failed to write CSV: %v", err)
+		}
+
+		df, err := polars.ReadCSVFiles([]string{path, second}, polars.ScanOptions{
+			SchemaOverrides: map[string]polars.DataType{"amount": polars.Decimal(18, 2)},
+		})
+		if err != nil {
+			t.Fatalf("Failed to read CSV files: %v", err)
+		}
+		defer df.Free()
+
+		amount, _ := df.Column("amount")
+		if amount.Dtype() != polars.Decimal(18, 2) {
+			t.Fatalf("Expected Decimal(18, 2), got %s", amount.Dtype())
+		}
+		v, _ := amount.Get(2)
+		if r, ok := v.(*big.Rat); !ok || r.Cmp(rat(t, "0.30")) != 0 {
+			t.Errorf("Expected 0.30, got %v", v)
+		}
+	})
+
+	t.Run("DefaultInference", func(t *testing.T) {
+		df, err := polars.ReadCSV(path)
+		if err != nil {
+			t.Fatalf("Failed to read CSV: %v", err)
+		}
+		defer df.Free()
+
+		amount, _ := df.Column("amount")
+		if amount.Dtype() != polars.Float64 {
+			t.Errorf("Expected Float64 without overrides, got %s", amount.Dtype())
+		}
+	})
+}
+
+func TestReadCSVInferDecimals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	content := "item,price,ratio\na,12.50,1e-3\nb,0.1,2.5\nc,-3,0.25\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+
+	df, err := polars.ReadCSV(path, polars.CSVOptions{InferDecimals: true})
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+	defer df.Free()
+
+	price, err := df.Column("price")
+	if err != nil {
+		t.Fatalf("Failed to get column: %v", err)
+	}
+	if price.Dtype() != polars.Decimal(38, 2) {
+		t.Fatalf("Expected Decimal(38, 2), got %s", price.Dtype())
+	}
+	for i, want := range []string{"12.50", "0.10", "-3"} {
+		v, err := price.Get(i)
+		if err != nil {
+			t.Fatalf("Failed to get value: %v", err)
+		}
+		if r, ok := v.(*big.Rat); !ok || r.Cmp(rat(t, want)) != 0 {
+			t.Errorf("Expected %s at row %d, got %v", want, i, v)
+		}
+	}
+
+	// Scientific notation is not a plain decimal number.
+	ratio, _ := df.Column("ratio")
+	if ratio.Dtype() != polars.Float64 {
+		t.Errorf("Expected Float64 for ratio, got %s", ratio.Dtype())
+	}
+
+	t.Run("Overrides", func(t *testing.T) {
+		df, err := polars.ReadCSV(path, polars.CSVOptions{
+			SchemaOverrides: map[string]polars.DataType{"price": polars.Float64},
+			InferDecimals:   true,
+		})
+		if err != nil {
+			t.Fatalf("Failed to read CSV: %v", err)
+		}
+		defer df.Free()
+
+		price, _ := df.Column("price")
+		if price.Dtype() != polars.Float64 {
+			t.Errorf("Expected the override to win, got %s", price.Dtype())
+		}
+	})
+
+	t.Run("MultipleFiles", func(t *testing.T) {
+		second := filepath.Join(t.TempDir(), "prices2.csv")
+		if err := os.WriteFile(second, []byte("item,price,ratio\nd,0.125,1\n"), 0o644); err != nil {
+			t.Fatalf("Failed to write CSV: %v", err)
+		}
+
+		df, err := polars.ReadCSVFiles([]string{path, second}, polars.ScanOptions{InferDecimals: true})
+		if err != nil {
+			t.Fatalf("Failed to read CSV files: %v", err)
+		}
+		defer df.Free()
+
+		price, _ := df.Column("price")
+		if price.Dtype() != polars.Decimal(38, 3) {
+			t.Fatalf("Expected Decimal(38, 3), got %s", price.Dtype())
+		}
+		v, _ := price.Get(3)
+		if r, ok := v.(*big.Rat); !ok || r.Cmp(rat(t, "0.125")) != 0 {
+			t.Errorf("Expected 0.125, got %v", v)
+		}
+	})
+}
+
+func TestListDecimalCast(t *testing.T) {
+	prices, err := polars.NewSeries("prices", [][]string{{"19.99", "0.10"}, {"-3.05"}})
+	if err != nil {
+		t.Fatalf("Failed to create Series: %v", err)
+	}
+
+	dtype := polars.List(polars.Decimal(10, 2))
+	decimals := prices.Cast(dtype)
+	defer decimals.Free()
+
+	if decimals.Dtype() != dtype {
+		t.Fatalf("Expected %s, got %s", dtype, decimals.Dtype())
+	}
+
+	v, err := decimals.Get(0)
+	if err != nil {
+		t.Fatalf("Failed to get value: %v", err)
+	}
+	list, ok := v.([]any)
+	if !ok || len(list) != 2 {
+		t.Fatalf("Expected a list of 2 values, got %v", v)
+	}
+	for i, want := range []string{"19.99", "0.10"} {
+		if r, ok := list[i].(*big.Rat); !ok || r.Cmp(rat(t, want)) != 0 {
+			t.Errorf("Expected %s at position %d, got %v", want, i, list[i])
+		}
+	}
+
+	t.Run("Enum", func(t *testing.T) {
+		levels, _ := polars.NewSeries("levels", [][]string{{"low", "high"}})
+		dtype := polars.List(polars.Enum("low", "high"))
+		enums := levels.Cast(dtype)
+		defer enums.Free()
+
+		if enums.Dtype() != dtype {
+			t.Errorf("Expected %s with categories %v, got %v", dtype, dtype.Categories(), enums.Dtype())
+		}
+	})
+}
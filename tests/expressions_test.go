@@ -750,6 +750,68 @@ func TestWithColumnsEdgeCases(t *testing.T) {
 	})
 }
 
+// Test that expressions can be reused across operations
+func TestExpressionReuse(t *testing.T) {
+	df := loadTestData(t)
+
+	base := polars.Col("petal.length").Gt(4)
+	defer base.Free()
+
+	first := df.Filter(base)
+	defer first.Free()
+
+	second := df.Filter(base)
+	defer second.Free()
+
+	if first.Height() == 0 || first.Height() != second.Height() {
+		t.Errorf("Expected equal non-empty results, got %d and %d", first.Height(), second.Height())
+	}
+
+	// The expression can also be combined into new expressions
+	combined := base.And(polars.Col("petal.width").Gt(1.5))
+	defer combined.Free()
+
+	third := df.Filter(combined)
+	defer third.Free()
+
+	if third.Height() > first.Height() {
+		t.Errorf("Combined filter should not return more rows: %d > %d", third.Height(), first.Height())
+	}
+
+	// The original expression is still valid after being combined
+	fourth := df.Filter(base)
+	defer fourth.Free()
+
+	if fourth.Height() != first.Height() {
+		t.Errorf("Expected %d rows, got %d", first.Height(), fourth.Height())
+	}
+
+	t.Run("ReuseInSelectAndWithColumns", func(t *testing.T) {
+		doubled := polars.Col("sepal.width").MulValue(2).Alias("doubled")
+		defer doubled.Free()
+
+		selected := df.Select(doubled)
+		defer selected.Free()
+
+		withColumns := df.WithColumns(doubled)
+		defer withColumns.Free()
+
+		if selected.Width() != 1 {
+			t.Errorf("Expected 1 column, got %d", selected.Width())
+		}
+
+		if withColumns.Width() != df.Width()+1 {
+			t.Errorf("Expected %d columns, got %d", df.Width()+1, withColumns.Width())
+		}
+	})
+
+	t.Run("FreeIsIdempotent", func(t *testing.T) {
+		expr := polars.Col("variety")
+		expr.Free()
+		expr.Free()
+	})
+}
+
 func BenchmarkExpressionOperations(b *testing.B) {
 	// Load test data directly without using testing.T
 	csvPath := getTestDataPath()
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/jordandelbar/go-polars/polars"
@@ -256,6 +257,47 @@ func TestGroupByChaining(t *testing.T) {
 	}
 }
 
+func TestGroupByReuse(t *testing.T) {
+	df, err := polars.ReadCSV("../examples/data/iris.csv")
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	gb := df.GroupBy("variety")
+	defer gb.Free()
+
+	// Several aggregations on the same GroupBy
+	sumResult := gb.Sum("petal.length")
+	defer sumResult.Free()
+
+	meanResult := gb.Mean("petal.length")
+	defer meanResult.Free()
+
+	countResult := gb.Count()
+	defer countResult.Free()
+
+	aggExpr := polars.Col("petal.width").Max()
+	defer aggExpr.Free()
+
+	firstAgg := gb.Agg(aggExpr)
+	defer firstAgg.Free()
+
+	secondAgg := gb.Agg(aggExpr)
+	defer secondAgg.Free()
+
+	for name, result := range map[string]*polars.DataFrame{
+		"sum":        sumResult,
+		"mean":       meanResult,
+		"count":      countResult,
+		"first agg":  firstAgg,
+		"second agg": secondAgg,
+	} {
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 groups for %s, got %d", name, result.Height())
+		}
+	}
+}
+
 func TestGroupByErrorHandling(t *testing.T) {
 	df, err := polars.ReadCSV("../examples/data/iris.csv")
 	if err != nil {
@@ -272,6 +314,174 @@ func TestGroupByErrorHandling(t *testing.T) {
 	_ = result
 }
 
+func TestGroupByAdvancedAggregations(t *testing.T) {
+	df, err := polars.ReadCSV("../examples/data/iris.csv")
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	gb := df.GroupBy("variety")
+	defer gb.Free()
+
+	// Several aggregations of the same column in one pass
+	aggResult := gb.Agg(
+		polars.Col("petal.length").Median().Alias("median"),
+		polars.Col("petal.length").Quantile(0.9, polars.QuantileLinear).Alias("p90"),
+		polars.Col("petal.length").Var().Alias("var"),
+		polars.Col("petal.length").First().Alias("first"),
+		polars.Col("petal.length").Last().Alias("last"),
+		polars.Col("petal.length").NUnique().Alias("n_unique"),
+		polars.Col("petal.length").NullCount().Alias("null_count"),
+		polars.Col("petal.length").AggList().Alias("values"),
+		polars.Col("petal.length").Len().Alias("len"),
+	)
+	defer aggResult.Free()
+
+	if aggResult.Height() != 3 {
+		t.Errorf("Expected 3 groups, got %d", aggResult.Height())
+	}
+
+	columns := aggResult.Columns()
+	expectedColumns := []string{"variety", "median", "p90", "var", "first", "last", "n_unique", "null_count", "values", "len"}
+	if len(columns) != len(expectedColumns) {
+		t.Fatalf("Expected %d columns, got %d", len(expectedColumns), len(columns))
+	}
+
+	for i, expected := range expectedColumns {
+		if columns[i] != expected {
+			t.Errorf("Expected column %d to be '%s', got '%s'", i, expected, columns[i])
+		}
+	}
+
+	// Every group has 50 rows and no nulls
+	complete := aggResult.Filter(polars.Col("len").Eq(50).And(polars.Col("null_count").Eq(0)))
+	defer complete.Free()
+
+	if complete.Height() != 3 {
+		t.Errorf("Expected 3 groups with 50 non-null rows, got %d", complete.Height())
+	}
+}
+
+func TestGroupByQuantileInterpolations(t *testing.T) {
+	df, err := polars.ReadCSV("../examples/data/iris.csv")
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	interpolations := []polars.QuantileInterpolation{
+		polars.QuantileNearest,
+		polars.QuantileLower,
+		polars.QuantileHigher,
+		polars.QuantileMidpoint,
+		polars.QuantileLinear,
+	}
+
+	for _, interpolation := range interpolations {
+		t.Run(string(interpolation), func(t *testing.T) {
+			gb := df.GroupBy("variety")
+			defer gb.Free()
+
+			result := gb.Agg(polars.Col("sepal.width").Quantile(0.25, interpolation))
+			defer result.Free()
+
+			if result.Height() != 3 {
+				t.Errorf("Expected 3 groups, got %d", result.Height())
+			}
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		expr := polars.Col("sepal.width").Quantile(0.25, polars.QuantileInterpolation("cubic"))
+		if result := df.Select(expr); result.Width() != 0 {
+			t.Error("Expected empty result for unknown interpolation")
+		}
+	})
+}
+
+func TestGroupByMaintainOrder(t *testing.T) {
+	df, err := polars.ReadCSV("../examples/data/iris.csv")
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	gb := df.GroupByStable("variety")
+	defer gb.Free()
+
+	result := gb.Count()
+	defer result.Free()
+
+	// Groups appear in the same order as in the file
+	output := result.String()
+	setosa := strings.Index(output, "Setosa")
+	versicolor := strings.Index(output, "Versicolor")
+	virginica := strings.Index(output, "Virginica")
+	if setosa < 0 || !(setosa < versicolor && versicolor < virginica) {
+		t.Errorf("Expected groups in input order, got:\n%s", output)
+	}
+
+	// Column names are passed as-is, so they may contain commas
+	renamed := df.Rename(map[string]string{"variety": "variety,kind"})
+	defer renamed.Free()
+
+	byName := renamed.GroupByStable("variety,kind")
+	defer byName.Free()
+
+	counts := byName.Count()
+	defer counts.Free()
+
+	if counts.Height() != 3 {
+		t.Errorf("Expected 3 groups, got %d", counts.Height())
+	}
+}
+
+func TestGroupByExpressions(t *testing.T) {
+	df, err := polars.ReadCSV("../examples/data/iris.csv")
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	t.Run("ComputedKey", func(t *testing.T) {
+		gb := df.GroupByExprs([]polars.Expr{
+			polars.Col("petal.length").Gt(4).Alias("long_petal"),
+		}, false)
+		defer gb.Free()
+
+		result := gb.Count()
+		defer result.Free()
+
+		if result.Height() != 2 {
+			t.Errorf("Expected 2 groups, got %d", result.Height())
+		}
+
+		columns := result.Columns()
+		if len(columns) != 2 || columns[0] != "long_petal" {
+			t.Errorf("Expected columns [long_petal count], got %v", columns)
+		}
+	})
+
+	t.Run("MaintainOrder", func(t *testing.T) {
+		gb := df.GroupByExprs([]polars.Expr{polars.Col("variety")}, true)
+		defer gb.Free()
+
+		result := gb.Agg(polars.Col("sepal.length").Mean())
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 groups, got %d", result.Height())
+		}
+	})
+
+	t.Run("NoKeys", func(t *testing.T) {
+		gb := df.GroupByExprs(nil, false)
+		defer gb.Free()
+
+		result := gb.Count()
+		if result.Height() != 0 {
+			t.Errorf("Expected empty result without keys, got %d rows", result.Height())
+		}
+	})
+}
+
 func BenchmarkGroupByCount(b *testing.B) {
 	df, err := polars.ReadCSV("../examples/data/iris.csv")
 	if err != nil {
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+// columnValues returns every value of the named column.
+func columnValues(t *testing.T, df *polars.DataFrame, name string) []any {
+	t.Helper()
+	col, err := df.Column(name)
+	if err != nil {
+		t.Fatalf("Failed to get column %q: %v", name, err)
+	}
+	values := make([]any, col.Len())
+	for i := range values {
+		values[i], err = col.Get(i)
+		if err != nil {
+			t.Fatalf("Failed to get value %d: %v", i, err)
+		}
+	}
+	return values
+}
+
+// assertValues compares column values with ==, so nil matches a null.
+func assertValues(t *testing.T, got []any, want ...any) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+			return
+		}
+	}
+}
+
+// columnValuesAt returns the value at row i of the named column.
+func columnValuesAt(t *testing.T, df *polars.DataFrame, name string, i int) any {
+	t.Helper()
+	col, err := df.Column(name)
+	if err != nil {
+		t.Fatalf("Failed to get column %q: %v", name, err)
+	}
+	value, err := col.Get(i)
+	if err != nil {
+		t.Fatalf("Failed to get value %d of column %q: %v", i, name, err)
+	}
+	return value
+}
+
+// approxEqual reports whether two floats are equal up to rounding errors.
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// assertColumns checks the column names of df, in order.
+func assertColumns(t *testing.T, df *polars.DataFrame, expected ...string) {
+	t.Helper()
+	if columns := df.Columns(); !reflect.DeepEqual(columns, expected) {
+		t.Errorf("Expected columns %v, got %v", expected, columns)
+	}
+}
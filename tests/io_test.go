@@ -392,3 +392,245 @@ func BenchmarkIOOperations(b *testing.B) {
 		}
 	})
 }
+
+// Test reading several files into one DataFrame
+func TestMultiFileReads(t *testing.T) {
+	tempDir := t.TempDir()
+
+	writePart := func(t *testing.T, dir string, hosts []string, requests []int64) {
+		t.Helper()
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		df, err := polars.NewDataFrame().
+			AddStringColumn("host", hosts).
+			AddIntColumn("requests", requests).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer df.Free()
+		if err := df.WriteParquet(filepath.Join(dir, "part-0.parquet")); err != nil {
+			t.Fatalf("Failed to write Parquet: %v", err)
+		}
+		if err := df.WriteCSV(filepath.Join(dir, "part-0.csv")); err != nil {
+			t.Fatalf("Failed to write CSV: %v", err)
+		}
+	}
+
+	writePart(t, filepath.Join(tempDir, "year=2023", "month=12"), []string{"a"}, []int64{1})
+	writePart(t, filepath.Join(tempDir, "year=2024", "month=01"), []string{"a", "b"}, []int64{2, 3})
+	writePart(t, filepath.Join(tempDir, "year=2024", "month=02"), []string{"c"}, []int64{4})
+
+	pattern := filepath.Join(tempDir, "year=*", "month=*", "*.parquet")
+
+	t.Run("ScanParquetGlob", func(t *testing.T) {
+		df, err := polars.ScanParquet(pattern)
+		if err != nil {
+			t.Fatalf("Failed to scan Parquet: %v", err)
+		}
+		defer df.Free()
+
+		if df.Height() != 4 || df.Width() != 2 {
+			t.Errorf("Expected 4x2 DataFrame, got %dx%d", df.Height(), df.Width())
+		}
+	})
+
+	t.Run("ScanParquetHive", func(t *testing.T) {
+		df, err := polars.ScanParquet(pattern, polars.ScanOptions{HivePartitioning: true})
+		if err != nil {
+			t.Fatalf("Failed to scan Parquet: %v", err)
+		}
+		defer df.Free()
+
+		columns := df.Columns()
+		expected := []string{"host", "requests", "year", "month"}
+		if len(columns) != len(expected) {
+			t.Fatalf("Expected columns %v, got %v", expected, columns)
+		}
+		for i, name := range expected {
+			if columns[i] != name {
+				t.Errorf("Expected column %d to be %q, got %q", i, name, columns[i])
+			}
+		}
+
+		filtered := df.Filter(polars.Col("year").Eq(2024))
+		defer filtered.Free()
+		if filtered.Height() != 3 {
+			t.Errorf("Expected 3 rows for 2024, got %d", filtered.Height())
+		}
+	})
+
+	t.Run("ScanParquetFilePaths", func(t *testing.T) {
+		df, err := polars.ScanParquet(pattern, polars.ScanOptions{IncludeFilePaths: "source"})
+		if err != nil {
+			t.Fatalf("Failed to scan Parquet: %v", err)
+		}
+		defer df.Free()
+
+		row, err := df.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		source, ok := row[len(row)-1].(string)
+		if !ok || filepath.Base(filepath.Dir(source)) != "month=12" {
+			t.Errorf("Expected source file column, got %v", row)
+		}
+	})
+
+	t.Run("ScanParquetNoMatch", func(t *testing.T) {
+		if _, err := polars.ScanParquet(filepath.Join(tempDir, "missing", "*.parquet")); err == nil {
+			t.Error("Expected error when no files match")
+		}
+	})
+
+	t.Run("ReadCSVFiles", func(t *testing.T) {
+		paths := []string{
+			filepath.Join(tempDir, "year=2024", "month=02", "part-0.csv"),
+			filepath.Join(tempDir, "year=2023", "month=12", "part-0.csv"),
+		}
+		df, err := polars.ReadCSVFiles(paths, polars.ScanOptions{IncludeFilePaths: "source"})
+		if err != nil {
+			t.Fatalf("Failed to read CSV files: %v", err)
+		}
+		defer df.Free()
+
+		if df.Height() != 2 || df.Width() != 3 {
+			t.Fatalf("Expected 2x3 DataFrame, got %dx%d", df.Height(), df.Width())
+		}
+
+		row, err := df.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[0] != "c" || row[2] != paths[0] {
+			t.Errorf("Expected first row from first file, got %v", row)
+		}
+	})
+
+	t.Run("ReadCSVFilesErrors", func(t *testing.T) {
+		if _, err := polars.ReadCSVFiles(nil); err == nil {
+			t.Error("Expected error without paths")
+		}
+		if _, err := polars.ReadCSVFiles([]string{filepath.Join(tempDir, "missing.csv")}); err == nil {
+			t.Error("Expected error for missing file")
+		}
+	})
+}
+
+// Test hive-partitioned Parquet writes
+func TestWriteParquetPartitioned(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddIntColumn("year", []int64{2023, 2024, 2024, 2024, 2024}).
+		AddStringColumn("region", []string{"eu", "eu", "us", "eu", "eu"}).
+		AddFloatColumn("sales", []float64{1, 2, 3, 4, 5}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("Layout", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := df.WriteParquetPartitioned(dir, []string{"year", "region"}, polars.PartitionOptions{}); err != nil {
+			t.Fatalf("Failed to write partitioned dataset: %v", err)
+		}
+
+		for _, part := range []string{"year=2023/region=eu", "year=2024/region=eu", "year=2024/region=us"} {
+			path := filepath.Join(dir, filepath.FromSlash(part), "part-0.parquet")
+			if _, err := os.Stat(path); err != nil {
+				t.Errorf("Expected file %s: %v", path, err)
+			}
+		}
+
+		part, err := polars.ReadParquet(filepath.Join(dir, "year=2024", "region=eu", "part-0.parquet"))
+		if err != nil {
+			t.Fatalf("Failed to read partition: %v", err)
+		}
+		defer part.Free()
+
+		if part.Height() != 3 {
+			t.Errorf("Expected 3 rows in partition, got %d", part.Height())
+		}
+		if columns := part.Columns(); len(columns) != 1 || columns[0] != "sales" {
+			t.Errorf("Expected partition columns to be dropped, got %v", columns)
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := df.WriteParquetPartitioned(dir, []string{"year", "region"}, polars.PartitionOptions{}); err != nil {
+			t.Fatalf("Failed to write partitioned dataset: %v", err)
+		}
+
+		result, err := polars.ScanParquet(filepath.Join(dir, "**", "*.parquet"), polars.ScanOptions{HivePartitioning: true})
+		if err != nil {
+			t.Fatalf("Failed to scan partitioned dataset: %v", err)
+		}
+		defer result.Free()
+
+		if result.Height() != df.Height() || result.Width() != df.Width() {
+			t.Errorf("Expected %dx%d DataFrame, got %dx%d", df.Height(), df.Width(), result.Height(), result.Width())
+		}
+	})
+
+	t.Run("MaxRowsPerFile", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := polars.PartitionOptions{MaxRowsPerFile: 2, KeepPartitionColumns: true}
+		if err := df.WriteParquetPartitioned(dir, []string{"region"}, opts); err != nil {
+			t.Fatalf("Failed to write partitioned dataset: %v", err)
+		}
+
+		files, err := filepath.Glob(filepath.Join(dir, "region=eu", "*.parquet"))
+		if err != nil {
+			t.Fatalf("Failed to list files: %v", err)
+		}
+		if len(files) != 2 {
+			t.Errorf("Expected 2 files for 4 rows, got %d", len(files))
+		}
+
+		part, err := polars.ReadParquet(filepath.Join(dir, "region=eu", "part-1.parquet"))
+		if err != nil {
+			t.Fatalf("Failed to read partition: %v", err)
+		}
+		defer part.Free()
+
+		if part.Height() != 2 || part.Width() != 3 {
+			t.Errorf("Expected 2x3 file, got %dx%d", part.Height(), part.Width())
+		}
+	})
+
+	t.Run("WriteTwice", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := polars.PartitionOptions{MaxRowsPerFile: 1}
+		if err := df.WriteParquetPartitioned(dir, []string{"region"}, opts); err != nil {
+			t.Fatalf("Failed to write partitioned dataset: %v", err)
+		}
+
+		fewer := df.Head(2)
+		defer fewer.Free()
+		if err := fewer.WriteParquetPartitioned(dir, []string{"region"}, opts); err == nil {
+			t.Fatal("Expected error when partition directories already hold files")
+		}
+
+		// The failed write must leave the first dataset untouched.
+		result, err := polars.ScanParquet(filepath.Join(dir, "**", "*.parquet"), polars.ScanOptions{HivePartitioning: true})
+		if err != nil {
+			t.Fatalf("Failed to scan partitioned dataset: %v", err)
+		}
+		defer result.Free()
+		if result.Height() != df.Height() {
+			t.Errorf("Expected %d rows, got %d", df.Height(), result.Height())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := df.WriteParquetPartitioned(dir, nil, polars.PartitionOptions{}); err == nil {
+			t.Error("Expected error without partition columns")
+		}
+		if err := df.WriteParquetPartitioned(dir, []string{"missing"}, polars.PartitionOptions{}); err == nil {
+			t.Error("Expected error for missing partition column")
+		}
+	})
+}
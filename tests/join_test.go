@@ -2,6 +2,7 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jordandelbar/go-polars/polars"
 )
@@ -420,3 +421,459 @@ func TestDataFrameJoinChaining(t *testing.T) {
 		}
 	})
 }
+
+// Test joins on column slices
+func TestDataFrameJoinColumns(t *testing.T) {
+	leftDf, err := polars.NewDataFrame().
+		AddIntColumn("year", []int64{2020, 2020, 2021, 2021}).
+		AddStringColumn("quarter, fiscal", []string{"Q1", "Q2", "Q1", "Q2"}).
+		AddIntColumn("sales", []int64{1000, 1200, 1100, 1300}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create left DataFrame: %v", err)
+	}
+	defer leftDf.Free()
+
+	rightDf, err := polars.NewDataFrame().
+		AddIntColumn("yr", []int64{2020, 2020, 2021}).
+		AddStringColumn("quarter, fiscal", []string{"Q1", "Q2", "Q1"}).
+		AddIntColumn("sales", []int64{800, 900, 850}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create right DataFrame: %v", err)
+	}
+	defer rightDf.Free()
+
+	t.Run("CommaInColumnName", func(t *testing.T) {
+		result := leftDf.JoinColumns(rightDf,
+			[]string{"year", "quarter, fiscal"},
+			[]string{"yr", "quarter, fiscal"},
+			polars.JoinInner)
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 rows, got %d", result.Height())
+		}
+
+		expectedCols := []string{"year", "quarter, fiscal", "sales", "sales_right"}
+		columns := result.Columns()
+		for i, expected := range expectedCols {
+			if i >= len(columns) || columns[i] != expected {
+				t.Errorf("Expected column %d to be '%s', got %v", i, expected, columns)
+			}
+		}
+	})
+
+	t.Run("CustomSuffix", func(t *testing.T) {
+		result := leftDf.JoinColumns(rightDf,
+			[]string{"year", "quarter, fiscal"},
+			[]string{"yr", "quarter, fiscal"},
+			polars.JoinLeft,
+			polars.JoinOptions{Suffix: "_cost"})
+		defer result.Free()
+
+		columns := result.Columns()
+		if len(columns) != 4 || columns[3] != "sales_cost" {
+			t.Errorf("Expected suffixed column 'sales_cost', got %v", columns)
+		}
+	})
+
+	t.Run("KeepKeyColumns", func(t *testing.T) {
+		result := leftDf.JoinColumns(rightDf,
+			[]string{"year", "quarter, fiscal"},
+			[]string{"yr", "quarter, fiscal"},
+			polars.JoinInner,
+			polars.JoinOptions{Coalesce: polars.CoalesceKeep})
+		defer result.Free()
+
+		// The right keys are kept next to the left ones
+		if result.Width() != 6 {
+			t.Errorf("Expected 6 columns, got %d: %v", result.Width(), result.Columns())
+		}
+	})
+
+	t.Run("MismatchedKeys", func(t *testing.T) {
+		result := leftDf.JoinColumns(rightDf, []string{"year"}, []string{"yr", "quarter, fiscal"}, polars.JoinInner)
+		if result.Height() != 0 || result.Width() != 0 {
+			t.Error("Expected empty result for mismatched key counts")
+		}
+	})
+
+	t.Run("ExpressionKeys", func(t *testing.T) {
+		result := leftDf.JoinOnExprs(rightDf,
+			[]polars.Expr{polars.Col("year").Sub(polars.Lit(int64(1)))},
+			[]polars.Expr{polars.Col("yr")},
+			polars.JoinInner,
+			polars.JoinOptions{Suffix: "_prev"})
+		defer result.Free()
+
+		// Only 2021 rows have a matching previous year, each matching two rows
+		if result.Height() != 4 {
+			t.Errorf("Expected 4 rows, got %d", result.Height())
+		}
+	})
+}
+
+// Test join key validation
+func TestDataFrameJoinValidation(t *testing.T) {
+	customers, err := polars.NewDataFrame().
+		AddIntColumn("id", []int64{1, 2, 3}).
+		AddStringColumn("name", []string{"Alice", "Bob", "Charlie"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer customers.Free()
+
+	orders, err := polars.NewDataFrame().
+		AddIntColumn("id", []int64{1, 1, 2}).
+		AddFloatColumn("amount", []float64{10, 20, 30}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer orders.Free()
+
+	tests := []struct {
+		name     string
+		left     *polars.DataFrame
+		right    *polars.DataFrame
+		validate polars.JoinValidation
+		rows     int
+	}{
+		{"OneToManyValid", customers, orders, polars.ValidateOneToMany, 3},
+		{"ManyToOneValid", orders, customers, polars.ValidateManyToOne, 3},
+		{"OneToOneDuplicates", customers, orders, polars.ValidateOneToOne, 0},
+		{"ManyToOneDuplicates", customers, orders, polars.ValidateManyToOne, 0},
+		{"ManyToManyAlwaysValid", orders, orders, polars.ValidateManyToMany, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.left.JoinColumns(tt.right, []string{"id"}, []string{"id"}, polars.JoinInner,
+				polars.JoinOptions{Validate: tt.validate})
+			defer result.Free()
+
+			if result.Height() != tt.rows {
+				t.Errorf("Expected %d rows, got %d", tt.rows, result.Height())
+			}
+		})
+	}
+
+	t.Run("UnknownValidation", func(t *testing.T) {
+		result := customers.JoinColumns(orders, []string{"id"}, []string{"id"}, polars.JoinInner,
+			polars.JoinOptions{Validate: "2:2"})
+		if result.Width() != 0 {
+			t.Error("Expected empty result for unknown validation")
+		}
+	})
+}
+
+// Test matching null keys
+func TestDataFrameJoinNullsEqual(t *testing.T) {
+	base, err := polars.NewDataFrame().
+		AddIntColumn("k", []int64{1, 2, 3}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer base.Free()
+
+	// The first window is incomplete, giving keys [null, 2, 3]
+	df := base.WithColumns(polars.Col("k").RollingMax(2).Alias("key"))
+	defer df.Free()
+
+	t.Run("NullsDoNotMatch", func(t *testing.T) {
+		result := df.JoinColumns(df, []string{"key"}, []string{"key"}, polars.JoinInner)
+		defer result.Free()
+
+		if result.Height() != 2 {
+			t.Errorf("Expected 2 rows, got %d", result.Height())
+		}
+	})
+
+	t.Run("NullsEqual", func(t *testing.T) {
+		result := df.JoinColumns(df, []string{"key"}, []string{"key"}, polars.JoinInner,
+			polars.JoinOptions{NullsEqual: true})
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 rows, got %d", result.Height())
+		}
+	})
+}
+
+// Test semi, anti and cross joins
+func TestDataFrameJoinSemiAntiCross(t *testing.T) {
+	today, err := polars.NewDataFrame().
+		AddIntColumn("id", []int64{1, 2, 3, 4}).
+		AddStringColumn("name", []string{"Alice", "Bob", "Charlie", "David"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer today.Free()
+
+	yesterday, err := polars.NewDataFrame().
+		AddIntColumn("id", []int64{1, 2, 2}).
+		AddStringColumn("status", []string{"new", "new", "updated"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer yesterday.Free()
+
+	t.Run("SemiJoin", func(t *testing.T) {
+		result := today.Join(yesterday, "id", polars.JoinSemi)
+		defer result.Free()
+
+		// Duplicate right keys do not duplicate left rows
+		if result.Height() != 2 {
+			t.Errorf("Expected 2 rows, got %d", result.Height())
+		}
+
+		columns := result.Columns()
+		if len(columns) != 2 || columns[0] != "id" || columns[1] != "name" {
+			t.Errorf("Expected only left columns, got %v", columns)
+		}
+	})
+
+	t.Run("AntiJoin", func(t *testing.T) {
+		result := today.JoinColumns(yesterday, []string{"id"}, []string{"id"}, polars.JoinAnti)
+		defer result.Free()
+
+		if result.Height() != 2 {
+			t.Fatalf("Expected 2 new records, got %d", result.Height())
+		}
+		if result.Width() != 2 {
+			t.Errorf("Expected only left columns, got %v", result.Columns())
+		}
+
+		row, err := result.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[1] != "Charlie" {
+			t.Errorf("Expected Charlie as first new record, got %v", row[1])
+		}
+	})
+
+	t.Run("CrossJoin", func(t *testing.T) {
+		result := today.CrossJoin(yesterday)
+		defer result.Free()
+
+		if result.Height() != 12 {
+			t.Errorf("Expected 12 rows, got %d", result.Height())
+		}
+
+		expectedCols := []string{"id", "name", "id_right", "status"}
+		columns := result.Columns()
+		for i, expected := range expectedCols {
+			if i >= len(columns) || columns[i] != expected {
+				t.Errorf("Expected column %d to be '%s', got %v", i, expected, columns)
+			}
+		}
+	})
+
+	t.Run("CrossJoinWithKeys", func(t *testing.T) {
+		result := today.JoinColumns(yesterday, []string{"id"}, []string{"id"}, polars.JoinCross)
+		if result.Width() != 0 {
+			t.Error("Expected empty result for cross join with keys")
+		}
+	})
+
+	t.Run("MissingKeys", func(t *testing.T) {
+		result := today.JoinColumns(yesterday, nil, nil, polars.JoinInner)
+		if result.Width() != 0 {
+			t.Error("Expected empty result for inner join without keys")
+		}
+	})
+}
+
+// Test as-of joins
+func TestDataFrameJoinAsof(t *testing.T) {
+	at := func(minute int) time.Time {
+		return time.Date(2024, 1, 1, 9, minute, 0, 0, time.UTC)
+	}
+
+	trades, err := polars.NewDataFrame().
+		AddDatetimeColumn("time", []time.Time{at(1), at(5), at(10)}).
+		AddStringColumn("ticker", []string{"A", "B", "A"}).
+		AddFloatColumn("qty", []float64{100, 200, 300}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create trades: %v", err)
+	}
+	defer trades.Free()
+
+	quotes, err := polars.NewDataFrame().
+		AddDatetimeColumn("time", []time.Time{at(0), at(3), at(4), at(9)}).
+		AddStringColumn("ticker", []string{"A", "B", "A", "B"}).
+		AddFloatColumn("price", []float64{10, 20, 11, 21}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create quotes: %v", err)
+	}
+	defer quotes.Free()
+
+	prices := func(t *testing.T, df *polars.DataFrame) []any {
+		t.Helper()
+		column, err := df.Column("price")
+		if err != nil {
+			t.Fatalf("Failed to get price column: %v (columns %v)", err, df.Columns())
+		}
+		values := make([]any, column.Len())
+		for i := range values {
+			values[i], _ = column.Get(i)
+		}
+		return values
+	}
+
+	tests := []struct {
+		name      string
+		strategy  polars.AsofStrategy
+		by        []string
+		tolerance string
+		expected  []any
+	}{
+		{"Backward", polars.AsofBackward, nil, "", []any{10.0, 11.0, 21.0}},
+		{"Forward", polars.AsofForward, nil, "", []any{20.0, 21.0, nil}},
+		{"Nearest", polars.AsofNearest, nil, "", []any{10.0, 11.0, 21.0}},
+		{"ByTicker", polars.AsofBackward, []string{"ticker"}, "", []any{10.0, 20.0, 11.0}},
+		{"Tolerance", polars.AsofBackward, []string{"ticker"}, "2m", []any{10.0, 20.0, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := trades.JoinAsof(quotes, "time", "time", tt.strategy, tt.by, tt.tolerance)
+			defer result.Free()
+
+			if result.Height() != trades.Height() {
+				t.Fatalf("Expected %d rows, got %d", trades.Height(), result.Height())
+			}
+
+			got := prices(t, result)
+			for i, want := range tt.expected {
+				if got[i] != want {
+					t.Errorf("Expected price %v for trade %d, got %v", want, i, got[i])
+				}
+			}
+		})
+	}
+
+	t.Run("NumericKeys", func(t *testing.T) {
+		left, err := polars.NewDataFrame().AddIntColumn("x", []int64{1, 5, 10}).Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		right, err := polars.NewDataFrame().
+			AddIntColumn("x", []int64{0, 4}).
+			AddFloatColumn("price", []float64{1, 2}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+
+		result := left.JoinAsof(right, "x", "x", polars.AsofBackward, nil, "3")
+		got := prices(t, result)
+		expected := []any{1.0, 2.0, nil}
+		for i, want := range expected {
+			if got[i] != want {
+				t.Errorf("Expected price %v for row %d, got %v", want, i, got[i])
+			}
+		}
+	})
+
+	t.Run("InvalidArguments", func(t *testing.T) {
+		result := trades.JoinAsof(quotes, "time", "time", "sideways", nil, "")
+		if result.Width() != 0 {
+			t.Error("Expected empty result for unknown strategy")
+		}
+
+		result = trades.JoinAsof(quotes, "time", "time", polars.AsofBackward, nil, "soon")
+		if result.Width() != 0 {
+			t.Error("Expected empty result for invalid tolerance")
+		}
+	})
+}
+
+// Test joins on computed keys and non-equi conditions
+func TestDataFrameJoinOnExprsAndWhere(t *testing.T) {
+	events, err := polars.NewDataFrame().
+		AddIntColumn("event_id", []int64{1, 2, 3, 4}).
+		AddIntColumn("ts", []int64{5, 12, 18, 40}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create events: %v", err)
+	}
+	defer events.Free()
+
+	sessions, err := polars.NewDataFrame().
+		AddStringColumn("session", []string{"s1", "s2"}).
+		AddIntColumn("start", []int64{0, 10}).
+		AddIntColumn("end", []int64{10, 20}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create sessions: %v", err)
+	}
+	defer sessions.Free()
+
+	t.Run("ComputedKeys", func(t *testing.T) {
+		// Match events that happen two units after a session starts
+		result := events.JoinOnExprs(sessions,
+			[]polars.Expr{polars.Col("ts")},
+			[]polars.Expr{polars.Col("start").Add(polars.Lit(int64(2)))},
+			polars.JoinInner)
+		defer result.Free()
+
+		if result.Height() != 1 {
+			t.Fatalf("Expected 1 matched event, got %d", result.Height())
+		}
+		row, err := result.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[0] != int64(2) {
+			t.Errorf("Expected event 2, got %v", row[0])
+		}
+	})
+
+	t.Run("RangeJoin", func(t *testing.T) {
+		result := events.JoinWhere(sessions,
+			polars.Col("ts").Ge(polars.Col("start")),
+			polars.Col("ts").Lt(polars.Col("end")),
+		)
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Fatalf("Expected 3 events inside a session, got %d", result.Height())
+		}
+
+		sorted := result.Sort("event_id")
+		defer sorted.Free()
+
+		expected := map[int64]string{1: "s1", 2: "s2", 3: "s2"}
+		for row := range sorted.IterRowsMap() {
+			id := row["event_id"].(int64)
+			if row["session"] != expected[id] {
+				t.Errorf("Expected event %d in session %s, got %v", id, expected[id], row["session"])
+			}
+		}
+	})
+
+	t.Run("ExprComparisons", func(t *testing.T) {
+		result := sessions.Filter(polars.Col("end").Gt(polars.Col("start").Add(polars.Lit(int64(5)))))
+		defer result.Free()
+
+		if result.Height() != 2 {
+			t.Errorf("Expected 2 rows, got %d", result.Height())
+		}
+	})
+
+	t.Run("NoPredicates", func(t *testing.T) {
+		result := events.JoinWhere(sessions)
+		if result.Width() != 0 {
+			t.Error("Expected empty result without predicates")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestListColumns(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("post", []string{"a", "b", "c"}).
+		AddListColumn("tags", [][]string{{"go", "rust"}, {}, {"go", "go", "polars"}}).
+		AddListColumn("scores", [][]int64{{3, 1}, {}, {5, 2, 8}}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("Values", func(t *testing.T) {
+		tags, err := df.Column("tags")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		if tags.Dtype() != polars.List(polars.String) {
+			t.Errorf("Expected List(String), got %s", tags.Dtype())
+		}
+
+		row, err := df.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if !reflect.DeepEqual(row[1], []any{"go", "rust"}) || !reflect.DeepEqual(row[2], []any{int64(3), int64(1)}) {
+			t.Errorf("Unexpected list values: %v", row)
+		}
+
+		empty, _ := tags.Get(1)
+		if !reflect.DeepEqual(empty, []any{}) {
+			t.Errorf("Expected empty list, got %v", empty)
+		}
+	})
+
+	t.Run("NewSeries", func(t *testing.T) {
+		s, err := polars.NewSeries("xs", [][]float64{{1.5}, {2.5, 3.5}})
+		if err != nil {
+			t.Fatalf("Failed to create Series: %v", err)
+		}
+		if s.Dtype() != polars.List(polars.Float64) || s.Dtype().String() != "List(Float64)" {
+			t.Errorf("Expected List(Float64), got %s", s.Dtype())
+		}
+	})
+
+	t.Run("Explode", func(t *testing.T) {
+		result := df.Explode("tags")
+		defer result.Free()
+
+		// The empty list becomes a single null row.
+		assertValues(t, columnValues(t, result, "post"), "a", "a", "b", "c", "c", "c")
+		assertValues(t, columnValues(t, result, "tags"), "go", "rust", nil, "go", "go", "polars")
+	})
+
+	t.Run("ExplodeMultipleColumns", func(t *testing.T) {
+		result := df.Explode("tags", "scores")
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "scores"), int64(3), int64(1), nil, int64(5), int64(2), int64(8))
+	})
+
+	t.Run("ImplodeRoundTrip", func(t *testing.T) {
+		exploded := df.Explode("tags")
+		defer exploded.Free()
+
+		result := exploded.Select(polars.Col("tags").Implode())
+		defer result.Free()
+
+		all := columnValuesAt(t, result, "tags", 0)
+		if len(all.([]any)) != 6 {
+			t.Errorf("Expected 6 imploded values, got %v", all)
+		}
+	})
+
+	t.Run("ExplodeInvalidInput", func(t *testing.T) {
+		if result := df.Explode(); result.Width() != 0 {
+			t.Error("Expected empty result without columns")
+		}
+		if result := df.Explode("missing"); result.Width() != 0 {
+			t.Error("Expected empty result for unknown column")
+		}
+	})
+
+	t.Run("Len", func(t *testing.T) {
+		result := df.Select(polars.Col("tags").List().Len())
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "tags"), uint64(2), uint64(0), uint64(3))
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		result := df.Select(
+			polars.Col("tags").List().Get(0).Alias("first"),
+			polars.Col("tags").List().Get(-1).Alias("last"),
+		)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "first"), "go", nil, "go")
+		assertValues(t, columnValues(t, result, "last"), "rust", nil, "polars")
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		result := df.Filter(polars.Col("tags").List().Contains("rust"))
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "post"), "a")
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		result := df.Select(polars.Col("tags").List().Join(","))
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "tags"), "go,rust", "", "go,go,polars")
+	})
+
+	t.Run("SumAndMean", func(t *testing.T) {
+		result := df.Select(
+			polars.Col("scores").List().Sum().Alias("sum"),
+			polars.Col("scores").List().Mean().Alias("mean"),
+		)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "sum"), int64(4), int64(0), int64(15))
+		mean := columnValuesAt(t, result, "mean", 2)
+		if mean != 5.0 {
+			t.Errorf("Expected mean 5, got %v", mean)
+		}
+	})
+
+	t.Run("SortAndUnique", func(t *testing.T) {
+		result := df.Select(
+			polars.Col("scores").List().Sort(true).Alias("sorted"),
+			polars.Col("tags").List().Unique().Alias("unique"),
+		)
+		defer result.Free()
+
+		sorted := columnValuesAt(t, result, "sorted", 2)
+		if !reflect.DeepEqual(sorted, []any{int64(8), int64(5), int64(2)}) {
+			t.Errorf("Expected descending scores, got %v", sorted)
+		}
+		unique := columnValuesAt(t, result, "unique", 2)
+		if !reflect.DeepEqual(unique, []any{"go", "polars"}) {
+			t.Errorf("Expected distinct tags, got %v", unique)
+		}
+	})
+
+	t.Run("StrSplit", func(t *testing.T) {
+		csv, err := polars.NewDataFrame().
+			AddStringColumn("labels", []string{"red,green", "blue"}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer csv.Free()
+
+		result := csv.Select(polars.Col("labels").Str().Split(","))
+		defer result.Free()
+
+		labels := columnValuesAt(t, result, "labels", 0)
+		if !reflect.DeepEqual(labels, []any{"red", "green"}) {
+			t.Errorf("Expected split labels, got %v", labels)
+		}
+	})
+}
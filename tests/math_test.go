@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestMathExpressions(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddFloatColumn("x", []float64{-2.5, 0, 1.44, 9}).
+		AddIntColumn("n", []int64{-7, 0, 7, 10}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	result := df.Select(
+		polars.Col("x").Abs().Alias("abs"),
+		polars.Col("x").Round(0).Alias("round"),
+		polars.Col("x").Floor().Alias("floor"),
+		polars.Col("x").Ceil().Alias("ceil"),
+		polars.Col("x").Abs().Sqrt().Alias("sqrt"),
+		polars.Col("x").Sign().Alias("sign"),
+		polars.Col("x").Clip(polars.Lit(-1.0), polars.Lit(2.0)).Alias("clip"),
+		polars.Col("n").Mod(polars.Lit(3)).Alias("mod"),
+		polars.Col("n").FloorDiv(polars.Lit(2)).Alias("floordiv"),
+		polars.Col("n").Pow(polars.Lit(2)).Alias("pow"),
+	)
+	defer result.Free()
+
+	assertValues(t, columnValues(t, result, "abs"), 2.5, 0.0, 1.44, 9.0)
+	assertValues(t, columnValues(t, result, "round"), -3.0, 0.0, 1.0, 9.0)
+	assertValues(t, columnValues(t, result, "floor"), -3.0, 0.0, 1.0, 9.0)
+	assertValues(t, columnValues(t, result, "ceil"), -2.0, 0.0, 2.0, 9.0)
+	assertValues(t, columnValues(t, result, "clip"), -1.0, 0.0, 1.44, 2.0)
+	assertValues(t, columnValues(t, result, "mod"), int64(2), int64(0), int64(1), int64(1))
+	assertValues(t, columnValues(t, result, "floordiv"), int64(-4), int64(0), int64(3), int64(5))
+	assertValues(t, columnValues(t, result, "pow"), int64(49), int64(0), int64(49), int64(100))
+
+	sqrt := columnValues(t, result, "sqrt")
+	if got, ok := sqrt[3].(float64); !ok || !approxEqual(got, 3) {
+		t.Errorf("Expected sqrt(9) = 3, got %v", sqrt[3])
+	}
+	sign := columnValues(t, result, "sign")
+	if sign[0] != -1.0 || sign[1] != 0.0 || sign[3] != 1.0 {
+		t.Errorf("Unexpected signs: %v", sign)
+	}
+
+	t.Run("NegativeDecimals", func(t *testing.T) {
+		if result := df.Select(polars.Col("x").Round(-1)); result.Width() != 0 {
+			t.Error("Expected empty result for negative decimals")
+		}
+	})
+}
+
+func TestLogExpAndTrig(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddFloatColumn("x", []float64{1, 100}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	result := df.Select(
+		polars.Col("x").Log(10).Alias("log10"),
+		polars.Col("x").Log(math.E).Exp().Alias("roundtrip"),
+		polars.Col("x").Sin().Alias("sin"),
+		polars.Col("x").Cos().Alias("cos"),
+		polars.Col("x").Tan().Arctan().Alias("arctan"),
+		polars.Col("x").Tanh().Alias("tanh"),
+	)
+	defer result.Free()
+
+	row, err := result.Row(0)
+	if err != nil {
+		t.Fatalf("Failed to get row: %v", err)
+	}
+	expected := []float64{0, 1, math.Sin(1), math.Cos(1), 1, math.Tanh(1)}
+	for i, want := range expected {
+		if got, ok := row[i].(float64); !ok || !approxEqual(got, want) {
+			t.Errorf("Expected %v in column %d, got %v", want, i, row[i])
+		}
+	}
+
+	last, _ := result.Row(1)
+	if got, ok := last[0].(float64); !ok || !approxEqual(got, 2) {
+		t.Errorf("Expected log10(100) = 2, got %v", last[0])
+	}
+}
@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+// waitForRustHeap runs the garbage collector until the Rust heap shrinks back
+// to at most limit bytes, giving finalizers time to run.
+func waitForRustHeap(limit uint64) uint64 {
+	allocated := polars.RustAllocatedBytes()
+	for i := 0; i < 50 && allocated > limit; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		allocated = polars.RustAllocatedBytes()
+	}
+	return allocated
+}
+
+func TestAutomaticMemoryManagement(t *testing.T) {
+	df := loadTestData(t)
+	defer df.Free()
+
+	baseline := waitForRustHeap(0)
+
+	t.Run("UnfreedIntermediates", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			result := df.
+				Filter(polars.Col("petal.length").Gt(1)).
+				WithColumns(polars.Col("sepal.width").MulValue(2).Alias("doubled")).
+				Select(polars.Col("variety"), polars.Col("doubled")).
+				Head(10)
+			if result.Height() != 10 {
+				t.Fatalf("Expected 10 rows, got %d", result.Height())
+			}
+		}
+
+		// Allow some slack for allocator caches inside polars
+		limit := baseline + 1<<20
+		if allocated := waitForRustHeap(limit); allocated > limit {
+			t.Errorf("Rust heap did not shrink after GC: baseline %d, now %d", baseline, allocated)
+		}
+	})
+
+	t.Run("UnfreedGroupBy", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			result := df.GroupBy("variety").Agg(polars.Col("petal.length").Mean())
+			if result.Height() != 3 {
+				t.Fatalf("Expected 3 groups, got %d", result.Height())
+			}
+		}
+
+		limit := baseline + 1<<20
+		if allocated := waitForRustHeap(limit); allocated > limit {
+			t.Errorf("Rust heap did not shrink after GC: baseline %d, now %d", baseline, allocated)
+		}
+	})
+
+	t.Run("StringsReturnedToGo", func(t *testing.T) {
+		// Every string cell, column name and error message is allocated by
+		// Rust and must be released back to it.
+		for i := 0; i < 20; i++ {
+			for row := range df.Rows() {
+				if _, ok := row[4].(string); !ok {
+					t.Fatalf("Expected string variety, got %v", row[4])
+				}
+			}
+			df.Columns()
+			df.Select(polars.Col("missing"))
+		}
+
+		limit := baseline + 1<<20
+		if allocated := waitForRustHeap(limit); allocated > limit {
+			t.Errorf("Rust heap grew while reading strings: baseline %d, now %d", baseline, allocated)
+		}
+	})
+
+	t.Run("NestedRowsReturnedToGo", func(t *testing.T) {
+		polars.AssertNoLeaks(t)
+
+		// List and struct cells own nested arrays and field names, which are
+		// released value by value.
+		nested, err := polars.NewDataFrame().
+			AddStringColumn("name", []string{"a", "b", "c"}).
+			AddListColumn("tags", [][]string{{"x", "y"}, {}, {"z"}}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer nested.Free()
+
+		withStruct := nested.WithColumns(polars.AsStruct(polars.Col("name"), polars.Col("tags")).Alias("record"))
+		defer withStruct.Free()
+
+		for i := 0; i < 200; i++ {
+			for row := range withStruct.Rows() {
+				if _, ok := row[2].(map[string]any); !ok {
+					t.Fatalf("Expected struct record, got %v", row[2])
+				}
+			}
+		}
+
+		limit := baseline + 1<<20
+		if allocated := waitForRustHeap(limit); allocated > limit {
+			t.Errorf("Rust heap grew while reading nested rows: baseline %d, now %d", baseline, allocated)
+		}
+	})
+
+	t.Run("FreeBeforeFinalizer", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			expr := polars.Col("petal.length").Gt(1)
+			filtered := df.Filter(expr)
+			gb := filtered.GroupBy("variety")
+
+			// Explicit Free combined with the finalizer must not double free
+			gb.Free()
+			gb.Free()
+			filtered.Free()
+			filtered.Free()
+			expr.Free()
+			expr.Free()
+		}
+		runtime.GC()
+		runtime.GC()
+	})
+}
+
+func TestRustAllocatedBytes(t *testing.T) {
+	// Let pending finalizers from earlier tests run first
+	runtime.GC()
+	time.Sleep(50 * time.Millisecond)
+
+	before := polars.RustAllocatedBytes()
+
+	df, err := polars.NewDataFrame().
+		AddFloatColumn("values", make([]float64, 1<<16)).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+
+	after := polars.RustAllocatedBytes()
+	if after < before+8*(1<<16) {
+		t.Errorf("Expected at least %d new bytes on the Rust heap, got %d", 8*(1<<16), after-before)
+	}
+
+	df.Free()
+	if freed := polars.RustAllocatedBytes(); freed >= after {
+		t.Errorf("Expected Rust heap to shrink after Free: %d >= %d", freed, after)
+	}
+}
+
+func TestEstimatedSize(t *testing.T) {
+	small, err := polars.NewDataFrame().
+		AddFloatColumn("values", make([]float64, 10)).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer small.Free()
+
+	large, err := polars.NewDataFrame().
+		AddFloatColumn("values", make([]float64, 10000)).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer large.Free()
+
+	if large.EstimatedSize() < 8*10000 {
+		t.Errorf("Expected at least %d bytes, got %d", 8*10000, large.EstimatedSize())
+	}
+
+	if small.EstimatedSize() >= large.EstimatedSize() {
+		t.Errorf("Expected small DataFrame to be smaller: %d >= %d", small.EstimatedSize(), large.EstimatedSize())
+	}
+
+	empty := &polars.DataFrame{}
+	if empty.EstimatedSize() != 0 {
+		t.Errorf("Expected 0 bytes for nil DataFrame, got %d", empty.EstimatedSize())
+	}
+}
+
+// settleStats runs the garbage collector until pending finalizers have run
+// and the live handle counts stop changing.
+func settleStats() polars.MemoryStats {
+	stats := polars.Stats()
+	for i := 0; i < 20; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		next := polars.Stats()
+		if next.DataFrames == stats.DataFrames && next.Exprs == stats.Exprs && next.GroupBys == stats.GroupBys && next.Series == stats.Series {
+			return next
+		}
+		stats = next
+	}
+	return stats
+}
+
+func TestStats(t *testing.T) {
+	before := settleStats()
+
+	df := loadTestData(t)
+	expr := polars.Col("petal.length")
+	gb := df.GroupBy("variety")
+
+	during := polars.Stats()
+	if during.DataFrames != before.DataFrames+1 {
+		t.Errorf("Expected %d DataFrames, got %d", before.DataFrames+1, during.DataFrames)
+	}
+	if during.Exprs != before.Exprs+1 {
+		t.Errorf("Expected %d Exprs, got %d", before.Exprs+1, during.Exprs)
+	}
+	if during.GroupBys != before.GroupBys+1 {
+		t.Errorf("Expected %d GroupBys, got %d", before.GroupBys+1, during.GroupBys)
+	}
+	if during.AllocatedBytes == 0 {
+		t.Error("Expected allocated bytes to be reported")
+	}
+
+	gb.Free()
+	expr.Free()
+	df.Free()
+
+	after := polars.Stats()
+	if after.DataFrames != before.DataFrames || after.Exprs != before.Exprs || after.GroupBys != before.GroupBys {
+		t.Errorf("Expected handle counts to return to %+v, got %+v", before, after)
+	}
+}
+
+// fakeT records failures reported by AssertNoLeaks.
+type fakeT struct {
+	cleanups []func()
+	failed   bool
+}
+
+func (f *fakeT) Helper()               {}
+func (f *fakeT) Cleanup(fn func())     { f.cleanups = append(f.cleanups, fn) }
+func (f *fakeT) Errorf(string, ...any) { f.failed = true }
+func (f *fakeT) finish() {
+	for i := len(f.cleanups) - 1; i >= 0; i-- {
+		f.cleanups[i]()
+	}
+}
+
+func TestAssertNoLeaks(t *testing.T) {
+	t.Run("NoLeaks", func(t *testing.T) {
+		polars.AssertNoLeaks(t)
+
+		df := loadTestData(t)
+		result := df.Filter(polars.Col("petal.length").Gt(1)).GroupBy("variety").Count()
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 groups, got %d", result.Height())
+		}
+	})
+
+	t.Run("DetectsLeak", func(t *testing.T) {
+		ft := &fakeT{}
+		polars.AssertNoLeaks(ft)
+
+		leaked := loadTestData(t)
+		ft.finish()
+
+		if !ft.failed {
+			t.Error("Expected AssertNoLeaks to report the reachable DataFrame")
+		}
+		leaked.Free()
+	})
+}
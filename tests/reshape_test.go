@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestPivot(t *testing.T) {
+	sales, err := polars.NewDataFrame().
+		AddStringColumn("month", []string{"jan", "jan", "jan", "feb", "feb"}).
+		AddStringColumn("region", []string{"eu", "us", "eu", "us", "eu"}).
+		AddIntColumn("amount", []int64{10, 20, 5, 30, 40}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer sales.Free()
+
+	t.Run("Sum", func(t *testing.T) {
+		result := sales.Pivot([]string{"region"}, []string{"month"}, []string{"amount"}, polars.PivotSum)
+		defer result.Free()
+
+		columns := result.Columns()
+		expected := []string{"month", "eu", "us"}
+		if len(columns) != len(expected) {
+			t.Fatalf("Expected columns %v, got %v", expected, columns)
+		}
+		for i, name := range expected {
+			if columns[i] != name {
+				t.Errorf("Expected column %d to be %q, got %q", i, name, columns[i])
+			}
+		}
+
+		assertValues(t, columnValues(t, result, "month"), "jan", "feb")
+		assertValues(t, columnValues(t, result, "eu"), int64(15), int64(40))
+		assertValues(t, columnValues(t, result, "us"), int64(20), int64(30))
+	})
+
+	t.Run("Len", func(t *testing.T) {
+		result := sales.Pivot([]string{"region"}, []string{"month"}, []string{"amount"}, polars.PivotLen)
+		defer result.Free()
+
+		// Counts are unsigned, so compare them as Int64.
+		counts, err := result.Column("eu")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		first, _ := counts.Cast(polars.Int64).Get(0)
+		if first != int64(2) {
+			t.Errorf("Expected 2 eu sales in jan, got %v", first)
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		if result := sales.Pivot(nil, []string{"month"}, []string{"amount"}, polars.PivotSum); result.Width() != 0 {
+			t.Error("Expected empty result without on columns")
+		}
+		if result := sales.Pivot([]string{"missing"}, []string{"month"}, []string{"amount"}, polars.PivotSum); result.Width() != 0 {
+			t.Error("Expected empty result for unknown column")
+		}
+		if result := sales.Pivot([]string{"region"}, []string{"month"}, []string{"amount"}, "mode"); result.Width() != 0 {
+			t.Error("Expected empty result for unknown aggregation")
+		}
+	})
+}
+
+func TestUnpivot(t *testing.T) {
+	wide, err := polars.NewDataFrame().
+		AddStringColumn("month", []string{"jan", "feb"}).
+		AddIntColumn("eu", []int64{15, 40}).
+		AddIntColumn("us", []int64{20, 30}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer wide.Free()
+
+	t.Run("NamedColumns", func(t *testing.T) {
+		result := wide.Unpivot([]string{"month"}, []string{"eu", "us"}, "region", "amount")
+		defer result.Free()
+
+		if result.Height() != 4 {
+			t.Fatalf("Expected 4 rows, got %d", result.Height())
+		}
+		assertValues(t, columnValues(t, result, "month"), "jan", "feb", "jan", "feb")
+		assertValues(t, columnValues(t, result, "region"), "eu", "eu", "us", "us")
+		assertValues(t, columnValues(t, result, "amount"), int64(15), int64(40), int64(20), int64(30))
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		result := wide.Unpivot([]string{"month"}, nil, "", "")
+		defer result.Free()
+
+		columns := result.Columns()
+		expected := []string{"month", "variable", "value"}
+		if len(columns) != len(expected) {
+			t.Fatalf("Expected columns %v, got %v", expected, columns)
+		}
+		for i, name := range expected {
+			if columns[i] != name {
+				t.Errorf("Expected column %d to be %q, got %q", i, name, columns[i])
+			}
+		}
+		if result.Height() != 4 {
+			t.Errorf("Expected 4 rows, got %d", result.Height())
+		}
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		if result := wide.Unpivot([]string{"missing"}, nil, "", ""); result.Width() != 0 {
+			t.Error("Expected empty result for unknown column")
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestRowAccess(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("name", []string{"Alice", "Bob", "Charlie"}).
+		AddIntColumn("age", []int64{25, 30, 35}).
+		AddFloatColumn("score", []float64{85.5, 92.0, 78.5}).
+		AddBoolColumn("active", []bool{true, false, true}).
+		AddDatetimeColumn("joined", []time.Time{
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("Row", func(t *testing.T) {
+		row, err := df.Row(1)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+
+		expected := []any{"Bob", int64(30), 92.0, false, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+		if len(row) != len(expected) {
+			t.Fatalf("Expected %d values, got %d", len(expected), len(row))
+		}
+		for i, want := range expected {
+			if row[i] != want {
+				t.Errorf("Expected %v at column %d, got %v (%T)", want, i, row[i], row[i])
+			}
+		}
+
+		for _, i := range []int{-1, 3} {
+			if _, err := df.Row(i); err == nil {
+				t.Errorf("Expected error for row index %d", i)
+			}
+		}
+	})
+
+	t.Run("AllRows", func(t *testing.T) {
+		var names []string
+		for row := range df.Rows() {
+			names = append(names, row[0].(string))
+		}
+
+		expected := []string{"Alice", "Bob", "Charlie"}
+		if len(names) != len(expected) {
+			t.Fatalf("Expected %d rows, got %d", len(expected), len(names))
+		}
+		for i, want := range expected {
+			if names[i] != want {
+				t.Errorf("Expected %q at row %d, got %q", want, i, names[i])
+			}
+		}
+	})
+
+	t.Run("AcrossBatches", func(t *testing.T) {
+		values := make([]int64, 2500)
+		for i := range values {
+			values[i] = int64(i)
+		}
+		large, err := polars.NewDataFrame().AddIntColumn("i", values).Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer large.Free()
+
+		count := 0
+		for row := range large.Rows() {
+			if row[0] != int64(count) {
+				t.Fatalf("Expected %d at row %d, got %v", count, count, row[0])
+			}
+			count++
+		}
+		if count != len(values) {
+			t.Errorf("Expected %d rows, got %d", len(values), count)
+		}
+	})
+
+	t.Run("EarlyBreak", func(t *testing.T) {
+		count := 0
+		for range df.Rows() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Errorf("Expected to stop after 2 rows, got %d", count)
+		}
+	})
+
+	t.Run("NullValues", func(t *testing.T) {
+		result := df.WithColumns(polars.Col("age").RollingSum(2).Alias("age_sum"))
+		defer result.Free()
+
+		row, err := result.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		if row[len(row)-1] != nil {
+			t.Errorf("Expected nil for incomplete window, got %v", row[len(row)-1])
+		}
+	})
+
+	t.Run("EmptyDataFrame", func(t *testing.T) {
+		empty := &polars.DataFrame{}
+		for range empty.Rows() {
+			t.Fatal("Expected no rows")
+		}
+	})
+
+	t.Run("IterRowsMap", func(t *testing.T) {
+		var rows []map[string]any
+		for row := range df.IterRowsMap() {
+			rows = append(rows, row)
+		}
+
+		if len(rows) != 3 {
+			t.Fatalf("Expected 3 rows, got %d", len(rows))
+		}
+		if rows[2]["name"] != "Charlie" || rows[2]["age"] != int64(35) || rows[2]["active"] != true {
+			t.Errorf("Unexpected row: %v", rows[2])
+		}
+		if len(rows[0]) != df.Width() {
+			t.Errorf("Expected %d keys, got %d", df.Width(), len(rows[0]))
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestRowSelection(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddIntColumn("id", []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("TailAndSlice", func(t *testing.T) {
+		tail := df.Tail(3)
+		defer tail.Free()
+		assertValues(t, columnValues(t, tail, "id"), int64(7), int64(8), int64(9))
+
+		slice := df.Slice(2, 3)
+		defer slice.Free()
+		assertValues(t, columnValues(t, slice, "id"), int64(2), int64(3), int64(4))
+
+		fromEnd := df.Slice(-2, 5)
+		defer fromEnd.Free()
+		assertValues(t, columnValues(t, fromEnd, "id"), int64(8), int64(9))
+	})
+
+	t.Run("Gather", func(t *testing.T) {
+		gathered := df.Gather([]int{9, 0, 9})
+		defer gathered.Free()
+		assertValues(t, columnValues(t, gathered, "id"), int64(9), int64(0), int64(9))
+
+		every := df.GatherEvery(4, 1)
+		defer every.Free()
+		assertValues(t, columnValues(t, every, "id"), int64(1), int64(5), int64(9))
+	})
+
+	t.Run("GatherOutOfBounds", func(t *testing.T) {
+		if result := df.Gather([]int{10}); result.Width() != 0 {
+			t.Error("Expected empty result for out-of-bounds index")
+		}
+		if result := df.Gather([]int{-1}); result.Width() != 0 {
+			t.Error("Expected empty result for negative index")
+		}
+	})
+
+	seed := uint64(42)
+	opts := polars.SampleOptions{Shuffle: true, Seed: &seed}
+
+	t.Run("Sample", func(t *testing.T) {
+		first := df.Sample(5, opts)
+		defer first.Free()
+		second := df.Sample(5, opts)
+		defer second.Free()
+
+		if first.Height() != 5 {
+			t.Fatalf("Expected 5 rows, got %d", first.Height())
+		}
+		if !reflect.DeepEqual(columnValues(t, first, "id"), columnValues(t, second, "id")) {
+			t.Error("Expected the same sample for the same seed")
+		}
+	})
+
+	t.Run("SampleFraction", func(t *testing.T) {
+		half := df.SampleFrac(0.5, opts)
+		defer half.Free()
+		if half.Height() != 5 {
+			t.Errorf("Expected 5 rows, got %d", half.Height())
+		}
+	})
+
+	t.Run("SampleWithReplacement", func(t *testing.T) {
+		if result := df.Sample(20); result.Width() != 0 {
+			t.Error("Expected empty result when sampling more rows than available")
+		}
+
+		more := df.Sample(20, polars.SampleOptions{WithReplacement: true})
+		defer more.Free()
+		if more.Height() != 20 {
+			t.Errorf("Expected 20 rows, got %d", more.Height())
+		}
+	})
+
+	t.Run("Expressions", func(t *testing.T) {
+		result := df.Select(
+			polars.Col("id").Head(2).Alias("head"),
+			polars.Col("id").Tail(2).Alias("tail"),
+		)
+		defer result.Free()
+		assertValues(t, columnValues(t, result, "head"), int64(0), int64(1))
+		assertValues(t, columnValues(t, result, "tail"), int64(8), int64(9))
+
+		seed := uint64(7)
+		sampled := df.Select(polars.Col("id").Sample(3, polars.SampleOptions{Seed: &seed}))
+		defer sampled.Free()
+		if sampled.Height() != 3 {
+			t.Errorf("Expected 3 sampled values, got %d", sampled.Height())
+		}
+	})
+	t.Run("NegativeArguments", func(t *testing.T) {
+		if result := df.Tail(-1); result.Width() != 0 {
+			t.Error("Expected empty result for negative tail")
+		}
+		if result := df.Slice(0, -1); result.Width() != 0 {
+			t.Error("Expected empty result for negative slice length")
+		}
+		if result := df.Sample(-1); result.Width() != 0 {
+			t.Error("Expected empty result for negative sample size")
+		}
+		if result := df.SampleFrac(-0.5); result.Width() != 0 {
+			t.Error("Expected empty result for negative sample fraction")
+		}
+
+		for name, expr := range map[string]polars.Expr{
+			"Head":       polars.Col("id").Head(-1),
+			"Tail":       polars.Col("id").Tail(-1),
+			"Sample":     polars.Col("id").Sample(-1),
+			"SampleFrac": polars.Col("id").SampleFrac(-0.5),
+		} {
+			if result := df.Select(expr); result.Width() != 0 {
+				t.Errorf("Expected empty result for negative %s", name)
+			}
+		}
+	})
+}
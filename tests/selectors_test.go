@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+	"github.com/jordandelbar/go-polars/polars/cs"
+)
+
+func TestSelectors(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("id_store", []string{"s1", "s1", "s2"}).
+		AddIntColumn("x_units", []int64{1, 2, 3}).
+		AddFloatColumn("x_price", []float64{1.5, 2.5, 3.5}).
+		AddStringColumn("label", []string{"a", "b", "c"}).
+		AddFloatColumn("score.total", []float64{0.1, 0.2, 0.3}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	tests := []struct {
+		name     string
+		selector polars.Expr
+		expected []string
+	}{
+		{"Numeric", cs.Numeric(), []string{"x_units", "x_price", "score.total"}},
+		{"StringCols", cs.StringCols(), []string{"id_store", "label"}},
+		{"ByDtype", cs.ByDtype(polars.Int64), []string{"x_units"}},
+		{"ByName", cs.ByName("label", "x_units"), []string{"x_units", "label"}},
+		{"StartsWith", cs.StartsWith("x_"), []string{"x_units", "x_price"}},
+		{"EndsWith", cs.EndsWith("_store", ".total"), []string{"id_store", "score.total"}},
+		{"Matches", cs.Matches("pri|lab"), []string{"x_price", "label"}},
+		{"MatchesAnchored", cs.Matches("^x"), []string{"x_units", "x_price"}},
+		{"MatchesAnchoredAlternation", cs.Matches("^x_u|total$"), []string{"x_units", "score.total"}},
+		{"Union", cs.Union(cs.StringCols(), cs.StartsWith("x_")), []string{"id_store", "x_units", "x_price", "label"}},
+		{"Intersection", cs.Intersection(cs.Numeric(), cs.StartsWith("x_")), []string{"x_units", "x_price"}},
+		{"Difference", cs.Difference(cs.All(), cs.Numeric(), cs.ByName("label")), []string{"id_store"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := df.Select(tt.selector)
+			defer result.Free()
+			assertColumns(t, result, tt.expected...)
+		})
+	}
+
+	t.Run("EmptyArguments", func(t *testing.T) {
+		for name, selector := range map[string]polars.Expr{
+			"ByName":     cs.ByName(),
+			"StartsWith": cs.StartsWith(),
+			"EndsWith":   cs.EndsWith(),
+			"Difference": cs.Difference(cs.All(), cs.StartsWith()),
+		} {
+			if result := df.Select(selector); result.Width() != 0 {
+				t.Errorf("Expected empty result for %s without arguments, got %v", name, result.Columns())
+			}
+		}
+	})
+
+	t.Run("InExpressions", func(t *testing.T) {
+		doubled := df.WithColumns(cs.StartsWith("x_").Mul(polars.Lit(2)))
+		defer doubled.Free()
+		assertValues(t, columnValues(t, doubled, "x_units"), int64(2), int64(4), int64(6))
+
+		totals := df.GroupBy("id_store").Agg(cs.Numeric().Sum())
+		defer totals.Free()
+		if totals.Width() != 4 {
+			t.Errorf("Expected key and 3 numeric columns, got %v", totals.Columns())
+		}
+	})
+}
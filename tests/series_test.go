@@ -0,0 +1,301 @@
+package tests
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestNewSeries(t *testing.T) {
+	t.Run("SupportedTypes", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			values any
+			dtype  polars.DataType
+			first  any
+		}{
+			{"strings", []string{"a", "b"}, polars.String, "a"},
+			{"ints", []int64{1, 2}, polars.Int64, int64(1)},
+			{"floats", []float64{1.5, 2.5}, polars.Float64, 1.5},
+			{"bools", []bool{true, false}, polars.Boolean, true},
+			{"times", []time.Time{time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}, polars.Datetime,
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				s, err := polars.NewSeries(tt.name, tt.values)
+				if err != nil {
+					t.Fatalf("Failed to create Series: %v", err)
+				}
+				defer s.Free()
+
+				if s.Name() != tt.name {
+					t.Errorf("Expected name %q, got %q", tt.name, s.Name())
+				}
+				if s.Dtype() != tt.dtype {
+					t.Errorf("Expected dtype %s, got %s", tt.dtype, s.Dtype())
+				}
+
+				value, err := s.Get(0)
+				if err != nil {
+					t.Fatalf("Failed to get value: %v", err)
+				}
+				if value != tt.first {
+					t.Errorf("Expected first value %v, got %v", tt.first, value)
+				}
+			})
+		}
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		if _, err := polars.NewSeries("bad", []complex128{1}); err == nil {
+			t.Error("Expected error for unsupported values type")
+		}
+	})
+
+	t.Run("GetOutOfBounds", func(t *testing.T) {
+		s, err := polars.NewSeries("values", []int64{1, 2, 3})
+		if err != nil {
+			t.Fatalf("Failed to create Series: %v", err)
+		}
+		defer s.Free()
+
+		if _, err := s.Get(3); err == nil {
+			t.Error("Expected error for out of bounds index")
+		}
+	})
+}
+
+func TestSeriesOperations(t *testing.T) {
+	a, err := polars.NewSeries("a", []float64{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("Failed to create Series: %v", err)
+	}
+	b, err := polars.NewSeries("b", []float64{4, 3, 2, 1})
+	if err != nil {
+		t.Fatalf("Failed to create Series: %v", err)
+	}
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			result   *polars.Series
+			expected []float64
+		}{
+			{"Add", a.Add(b), []float64{5, 5, 5, 5}},
+			{"Sub", a.Sub(b), []float64{-3, -1, 1, 3}},
+			{"Mul", a.Mul(b), []float64{4, 6, 6, 4}},
+			{"Div", a.Div(b), []float64{0.25, 2.0 / 3.0, 1.5, 4}},
+		}
+
+		for _, tt := range tests {
+			if tt.result.Len() != len(tt.expected) {
+				t.Fatalf("%s: expected %d values, got %d", tt.name, len(tt.expected), tt.result.Len())
+			}
+			for i, expected := range tt.expected {
+				value, err := tt.result.Get(i)
+				if err != nil {
+					t.Fatalf("%s: failed to get value %d: %v", tt.name, i, err)
+				}
+				if math.Abs(value.(float64)-expected) > 1e-9 {
+					t.Errorf("%s: expected %v at %d, got %v", tt.name, expected, i, value)
+				}
+			}
+		}
+	})
+
+	t.Run("NilOperand", func(t *testing.T) {
+		for name, result := range map[string]*polars.Series{
+			"Add": a.Add(nil),
+			"Sub": a.Sub(nil),
+			"Mul": a.Mul(nil),
+			"Div": a.Div(nil),
+		} {
+			if result.Len() != 0 {
+				t.Errorf("%s: expected empty Series for nil operand, got %d values", name, result.Len())
+			}
+		}
+	})
+
+	t.Run("Aggregations", func(t *testing.T) {
+		if sum, err := a.Sum(); err != nil || sum != 10.0 {
+			t.Errorf("Expected sum 10, got %v (%v)", sum, err)
+		}
+
+		// Integer sums stay exact beyond 2^53
+		large, err := polars.NewSeries("large", []int64{1 << 62, 1})
+		if err != nil {
+			t.Fatalf("Failed to create Series: %v", err)
+		}
+		if sum, err := large.Sum(); err != nil || sum != int64(1<<62+1) {
+			t.Errorf("Expected sum %d, got %v (%v)", int64(1<<62+1), sum, err)
+		}
+		if a.Mean() != 2.5 {
+			t.Errorf("Expected mean 2.5, got %v", a.Mean())
+		}
+
+		empty, err := polars.NewSeries("empty", []float64{})
+		if err != nil {
+			t.Fatalf("Failed to create Series: %v", err)
+		}
+		if !math.IsNaN(empty.Mean()) {
+			t.Errorf("Expected NaN mean for empty Series, got %v", empty.Mean())
+		}
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		sliced := a.Slice(1, 2)
+		if sliced.Len() != 2 {
+			t.Fatalf("Expected 2 values, got %d", sliced.Len())
+		}
+		if value, _ := sliced.Get(0); value != 2.0 {
+			t.Errorf("Expected first value 2, got %v", value)
+		}
+
+		tail := a.Slice(-1, 1)
+		if value, _ := tail.Get(0); value != 4.0 {
+			t.Errorf("Expected last value 4, got %v", value)
+		}
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		sorted := b.Sort(false)
+		if value, _ := sorted.Get(0); value != 1.0 {
+			t.Errorf("Expected smallest value first, got %v", value)
+		}
+
+		sorted = a.Sort(true)
+		if value, _ := sorted.Get(0); value != 4.0 {
+			t.Errorf("Expected largest value first, got %v", value)
+		}
+	})
+
+	t.Run("Cast", func(t *testing.T) {
+		ints := a.Cast(polars.Int32)
+		if ints.Dtype() != polars.Int32 {
+			t.Errorf("Expected Int32, got %s", ints.Dtype())
+		}
+		if value, _ := ints.Get(3); value != int64(4) {
+			t.Errorf("Expected 4, got %v", value)
+		}
+
+		strs := a.Cast(polars.String)
+		if value, _ := strs.Get(0); value != "1.0" {
+			t.Errorf("Expected \"1.0\", got %v", value)
+		}
+	})
+
+	t.Run("MismatchedLengths", func(t *testing.T) {
+		short := a.Slice(0, 2)
+		result := a.Add(short)
+		if result.Len() != 0 {
+			t.Errorf("Expected empty Series for mismatched lengths, got %d values", result.Len())
+		}
+	})
+}
+
+func TestSeriesUniqueAndValueCounts(t *testing.T) {
+	s, err := polars.NewSeries("fruit", []string{"apple", "pear", "apple", "fig", "apple", "pear"})
+	if err != nil {
+		t.Fatalf("Failed to create Series: %v", err)
+	}
+	defer s.Free()
+
+	unique := s.Unique()
+	defer unique.Free()
+
+	expected := []string{"apple", "pear", "fig"}
+	if unique.Len() != len(expected) {
+		t.Fatalf("Expected %d unique values, got %d", len(expected), unique.Len())
+	}
+	for i, want := range expected {
+		if value, _ := unique.Get(i); value != want {
+			t.Errorf("Expected %q at %d, got %v", want, i, value)
+		}
+	}
+
+	counts := s.ValueCounts()
+	defer counts.Free()
+
+	if counts.Height() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", counts.Height())
+	}
+
+	fruits, err := counts.Column("fruit")
+	if err != nil {
+		t.Fatalf("Failed to get fruit column: %v", err)
+	}
+	if value, _ := fruits.Get(0); value != "apple" {
+		t.Errorf("Expected most frequent value apple, got %v", value)
+	}
+
+	totals, err := counts.Column("count")
+	if err != nil {
+		t.Fatalf("Failed to get count column: %v", err)
+	}
+	if value, _ := totals.Get(0); value != uint64(3) {
+		t.Errorf("Expected count 3, got %v", value)
+	}
+}
+
+func TestDataFrameSeriesConversion(t *testing.T) {
+	df := loadTestData(t)
+	defer df.Free()
+
+	t.Run("Column", func(t *testing.T) {
+		s, err := df.Column("petal.length")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		defer s.Free()
+
+		if s.Len() != df.Height() {
+			t.Errorf("Expected %d values, got %d", df.Height(), s.Len())
+		}
+		if s.Dtype() != polars.Float64 {
+			t.Errorf("Expected Float64, got %s", s.Dtype())
+		}
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		if _, err := df.Column("missing"); err == nil {
+			t.Error("Expected error for missing column")
+		}
+	})
+
+	t.Run("WithSeries", func(t *testing.T) {
+		length, err := df.Column("petal.length")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		width, err := df.Column("petal.width")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+
+		result := df.WithSeries(length.Mul(width))
+		defer result.Free()
+
+		if result.Width() != df.Width() {
+			t.Errorf("Expected existing column to be replaced, got %d columns", result.Width())
+		}
+
+		area, err := polars.NewSeries("area", make([]float64, df.Height()))
+		if err != nil {
+			t.Fatalf("Failed to create Series: %v", err)
+		}
+		result = df.WithSeries(area)
+		if result.Width() != df.Width()+1 {
+			t.Errorf("Expected %d columns, got %d", df.Width()+1, result.Width())
+		}
+
+		short, _ := polars.NewSeries("short", []float64{1})
+		result = df.WithSeries(short)
+		if result.Height() != 0 {
+			t.Errorf("Expected empty DataFrame for mismatched length, got %d rows", result.Height())
+		}
+	})
+}
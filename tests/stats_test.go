@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestStatistics(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("sensor", []string{"a", "b", "c", "d"}).
+		AddFloatColumn("temperature", []float64{10, 20, 30, 40}).
+		AddIntColumn("load", []int64{1, 2, 3, 4}).
+		AddFloatColumn("noise", []float64{4, 1, 3, 2}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("Describe", func(t *testing.T) {
+		summary := df.Describe()
+		defer summary.Free()
+
+		assertValues(t, columnValues(t, summary, "statistic"),
+			"count", "null_count", "mean", "std", "min", "25%", "50%", "75%", "max")
+
+		temperature := columnValues(t, summary, "temperature")
+		if temperature[0] != 4.0 || temperature[1] != 0.0 || temperature[2] != 25.0 {
+			t.Errorf("Unexpected counts or mean: %v", temperature)
+		}
+		if std, ok := temperature[3].(float64); !ok || !approxEqual(std, math.Sqrt(500.0/3)) {
+			t.Errorf("Unexpected std: %v", temperature[3])
+		}
+		if temperature[4] != 10.0 || temperature[8] != 40.0 {
+			t.Errorf("Unexpected min or max: %v", temperature)
+		}
+
+		sensor := columnValues(t, summary, "sensor")
+		if sensor[0] != "4" || sensor[2] != nil || sensor[4] != "a" || sensor[8] != "d" {
+			t.Errorf("Unexpected string summary: %v", sensor)
+		}
+	})
+
+	t.Run("Percentiles", func(t *testing.T) {
+		summary := df.Describe(0.1, 0.9)
+		defer summary.Free()
+
+		if summary.Height() != 8 {
+			t.Fatalf("Expected 8 statistics, got %d", summary.Height())
+		}
+		statistics := columnValues(t, summary, "statistic")
+		if statistics[5] != "10%" || statistics[6] != "90%" {
+			t.Errorf("Unexpected percentile labels: %v", statistics)
+		}
+	})
+
+	t.Run("PercentileLabels", func(t *testing.T) {
+		summary := df.Describe(0.07, 0.125, 1)
+		defer summary.Free()
+
+		statistics := columnValues(t, summary, "statistic")
+		if statistics[5] != "7%" || statistics[6] != "12.5%" || statistics[7] != "100%" {
+			t.Errorf("Unexpected percentile labels: %v", statistics)
+		}
+	})
+
+	t.Run("StatisticColumn", func(t *testing.T) {
+		input, err := polars.NewDataFrame().
+			AddStringColumn("statistic", []string{"x", "y"}).
+			AddFloatColumn("value", []float64{1, 3}).
+			Build()
+		if err != nil {
+			t.Fatalf("Failed to create DataFrame: %v", err)
+		}
+		defer input.Free()
+
+		summary := input.Describe()
+		defer summary.Free()
+
+		assertColumns(t, summary, "_statistic", "statistic", "value")
+		labels := columnValues(t, summary, "_statistic")
+		if labels[0] != "count" || labels[8] != "max" {
+			t.Errorf("Unexpected statistic labels: %v", labels)
+		}
+		values := columnValues(t, summary, "statistic")
+		if values[4] != "x" || values[8] != "y" {
+			t.Errorf("Unexpected summary of the statistic column: %v", values)
+		}
+	})
+
+	t.Run("InvalidPercentile", func(t *testing.T) {
+		if result := df.Describe(1.5); result.Width() != 0 {
+			t.Error("Expected empty result for percentile above 1")
+		}
+	})
+
+	t.Run("CorrCov", func(t *testing.T) {
+		result := df.Select(
+			polars.Corr(polars.Col("temperature"), polars.Col("load"), polars.CorrPearson).Alias("pearson"),
+			polars.Corr(polars.Col("temperature"), polars.Col("noise"), polars.CorrSpearman).Alias("spearman"),
+			polars.Cov(polars.Col("temperature"), polars.Col("load")).Alias("cov"),
+		)
+		defer result.Free()
+
+		row, err := result.Row(0)
+		if err != nil {
+			t.Fatalf("Failed to get row: %v", err)
+		}
+		expected := []float64{1, -0.4, 50.0 / 3}
+		for i, want := range expected {
+			if got, ok := row[i].(float64); !ok || !approxEqual(got, want) {
+				t.Errorf("Expected %v in column %d, got %v", want, i, row[i])
+			}
+		}
+	})
+
+	t.Run("CorrMatrix", func(t *testing.T) {
+		matrix := df.Corr()
+		defer matrix.Free()
+
+		expected := []string{"temperature", "load", "noise"}
+		if !reflect.DeepEqual(matrix.Columns(), expected) {
+			t.Fatalf("Expected columns %v, got %v", expected, matrix.Columns())
+		}
+
+		load := columnValues(t, matrix, "load")
+		if got, ok := load[0].(float64); !ok || !approxEqual(got, 1) {
+			t.Errorf("Expected perfect correlation with temperature, got %v", load[0])
+		}
+		noise := columnValues(t, matrix, "noise")
+		if got, ok := noise[2].(float64); !ok || !approxEqual(got, 1) {
+			t.Errorf("Expected 1 on the diagonal, got %v", noise[2])
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestStructColumns(t *testing.T) {
+	flat, err := polars.NewDataFrame().
+		AddStringColumn("name", []string{"Alice", "Bob"}).
+		AddStringColumn("city", []string{"Paris", "Lyon"}).
+		AddIntColumn("zip", []int64{75001, 69001}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer flat.Free()
+
+	df := flat.Select(
+		polars.Col("name"),
+		polars.AsStruct(polars.Col("city"), polars.Col("zip")).Alias("address"),
+	)
+	defer df.Free()
+
+	t.Run("AsStruct", func(t *testing.T) {
+		address, err := df.Column("address")
+		if err != nil {
+			t.Fatalf("Failed to get column: %v", err)
+		}
+		if address.Dtype() != polars.Struct {
+			t.Errorf("Expected Struct, got %s", address.Dtype())
+		}
+
+		value, err := address.Get(1)
+		if err != nil {
+			t.Fatalf("Failed to get value: %v", err)
+		}
+		expected := map[string]any{"city": "Lyon", "zip": int64(69001)}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("Expected %v, got %v", expected, value)
+		}
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		result := df.Select(polars.Col("address").Struct().Field("city"))
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "city"), "Paris", "Lyon")
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+		if result := df.Select(polars.Col("address").Struct().Field("country")); result.Width() != 0 {
+			t.Error("Expected empty result for unknown field")
+		}
+	})
+
+	t.Run("Unnest", func(t *testing.T) {
+		result := df.Unnest("address")
+		defer result.Free()
+
+		columns := result.Columns()
+		expected := []string{"name", "city", "zip"}
+		if !reflect.DeepEqual(columns, expected) {
+			t.Errorf("Expected columns %v, got %v", expected, columns)
+		}
+
+		if result := df.Unnest("name"); result.Width() != 0 {
+			t.Error("Expected empty result for non-struct column")
+		}
+	})
+
+	type Address struct {
+		City string
+		Zip  int32 `polars:"zip"`
+	}
+	type Person struct {
+		Name    string
+		Address *Address
+		Ignored string `polars:"-"`
+	}
+
+	t.Run("DecodeStruct", func(t *testing.T) {
+		var people []Person
+		for row := range df.IterRowsMap() {
+			var p Person
+			if err := polars.DecodeStruct(row, &p); err != nil {
+				t.Fatalf("Failed to decode row: %v", err)
+			}
+			people = append(people, p)
+		}
+
+		if len(people) != 2 || people[0].Name != "Alice" || people[0].Address == nil {
+			t.Fatalf("Unexpected decoded rows: %+v", people)
+		}
+		if *people[1].Address != (Address{City: "Lyon", Zip: 69001}) {
+			t.Errorf("Unexpected address: %+v", *people[1].Address)
+		}
+	})
+
+	t.Run("DecodeStructErrors", func(t *testing.T) {
+		var p Person
+		if err := polars.DecodeStruct("not a struct", &p); err == nil {
+			t.Error("Expected error for non-struct value")
+		}
+		if err := polars.DecodeStruct(map[string]any{}, p); err == nil {
+			t.Error("Expected error for non-pointer destination")
+		}
+		if err := polars.DecodeStruct(map[string]any{"Name": int64(1)}, &p); err == nil {
+			t.Error("Expected error for mismatched field type")
+		}
+	})
+
+	t.Run("DecodeStructNumbers", func(t *testing.T) {
+		var small struct {
+			Count int8
+			Size  uint16
+			Ratio float32
+		}
+		if err := polars.DecodeStruct(map[string]any{"Count": int64(-5), "Size": float64(300), "Ratio": 0.5}, &small); err != nil {
+			t.Fatalf("Failed to decode numbers: %v", err)
+		}
+		if small.Count != -5 || small.Size != 300 || small.Ratio != 0.5 {
+			t.Errorf("Unexpected decoded values: %+v", small)
+		}
+
+		for name, value := range map[string]map[string]any{
+			"IntOverflow":     {"Count": int64(300)},
+			"NegativeToUint":  {"Size": int64(-1)},
+			"UintOverflow":    {"Count": uint64(1 << 63)},
+			"FractionToInt":   {"Count": 1.5},
+			"FloatOverflow":   {"Size": float64(1 << 20)},
+			"Float32Overflow": {"Ratio": 1e300},
+		} {
+			if err := polars.DecodeStruct(value, &small); err == nil {
+				t.Errorf("%s: expected error, got %+v", name, small)
+			}
+		}
+	})
+}
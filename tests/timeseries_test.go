@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestTimeSeriesWindows(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamps := make([]time.Time, 6)
+	for i := range timestamps {
+		timestamps[i] = start.Add(time.Duration(i*30) * time.Minute)
+	}
+
+	df, err := polars.NewDataFrame().
+		AddDatetimeColumn("time", timestamps).
+		AddStringColumn("host", []string{"a", "b", "a", "b", "a", "b"}).
+		AddFloatColumn("cpu", []float64{10, 20, 30, 40, 50, 60}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create time series DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	t.Run("HourlyWindows", func(t *testing.T) {
+		gb := df.GroupByDynamic("time", "1h", "", "")
+		defer gb.Free()
+
+		result := gb.Agg(polars.Col("cpu").Mean().Alias("avg_cpu"))
+		defer result.Free()
+
+		// 3 hours of data at 30 minute intervals
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 windows, got %d", result.Height())
+		}
+
+		columns := result.Columns()
+		expectedColumns := []string{"time", "avg_cpu"}
+		for i, expected := range expectedColumns {
+			if i >= len(columns) || columns[i] != expected {
+				t.Errorf("Expected column %d to be '%s', got '%v'", i, expected, columns)
+			}
+		}
+
+		// Windows are closed on the left: [00:00, 01:00) holds 10 and 20
+		assertValues(t, columnValues(t, result, "avg_cpu"), 15.0, 35.0, 55.0)
+	})
+
+	t.Run("WindowsPerKey", func(t *testing.T) {
+		gb := df.GroupByDynamic("time", "1h", "", "", "host")
+		defer gb.Free()
+
+		result := gb.Count()
+		defer result.Free()
+
+		// Each host has one row per hour
+		if result.Height() != 6 {
+			t.Errorf("Expected 6 windows, got %d", result.Height())
+		}
+	})
+
+	t.Run("KeyNameWithComma", func(t *testing.T) {
+		renamed := df.Rename(map[string]string{"host": "host,region"})
+		defer renamed.Free()
+
+		gb := renamed.GroupByDynamic("time", "1h", "", "", "host,region")
+		defer gb.Free()
+
+		result := gb.Count()
+		defer result.Free()
+
+		if result.Height() != 6 {
+			t.Errorf("Expected 6 windows, got %d", result.Height())
+		}
+
+		rolling := renamed.Rolling("time", "1h", "host,region")
+		defer rolling.Free()
+
+		perRow := rolling.Agg(polars.Col("cpu").Sum().Alias("cpu_last_hour"))
+		defer perRow.Free()
+
+		if perRow.Height() != df.Height() {
+			t.Errorf("Expected %d rows, got %d", df.Height(), perRow.Height())
+		}
+	})
+
+	t.Run("OverlappingWindows", func(t *testing.T) {
+		gb := df.GroupByDynamic("time", "1h", "2h", "")
+		defer gb.Free()
+
+		result := gb.Agg(polars.Col("cpu").Sum().Alias("total_cpu"))
+		defer result.Free()
+
+		if result.Height() != 3 {
+			t.Errorf("Expected 3 windows, got %d", result.Height())
+		}
+
+		// Two hour windows starting every hour: [00:00, 02:00), [01:00, 03:00), [02:00, 04:00)
+		assertValues(t, columnValues(t, result, "total_cpu"), 100.0, 180.0, 110.0)
+	})
+
+	t.Run("InvalidInterval", func(t *testing.T) {
+		gb := df.GroupByDynamic("time", "not-a-duration", "", "")
+		defer gb.Free()
+
+		result := gb.Count()
+		if result.Height() != 0 {
+			t.Errorf("Expected empty result for invalid interval, got %d rows", result.Height())
+		}
+	})
+
+	t.Run("Rolling", func(t *testing.T) {
+		gb := df.Rolling("time", "1h")
+		defer gb.Free()
+
+		result := gb.Agg(polars.Col("cpu").Sum().Alias("cpu_last_hour"))
+		defer result.Free()
+
+		// One window per row
+		if result.Height() != df.Height() {
+			t.Errorf("Expected %d rows, got %d", df.Height(), result.Height())
+		}
+
+		// Each window is (t - 1h, t], so it holds the current and previous row
+		assertValues(t, columnValues(t, result, "cpu_last_hour"), 10.0, 30.0, 50.0, 70.0, 90.0, 110.0)
+	})
+
+	t.Run("RollingExpressions", func(t *testing.T) {
+		result := df.WithColumns(
+			polars.Col("cpu").RollingMean(2).Alias("cpu_mean"),
+			polars.Col("cpu").RollingSum(3).Alias("cpu_sum"),
+			polars.Col("cpu").RollingMin(2).Alias("cpu_min"),
+			polars.Col("cpu").RollingMax(2).Alias("cpu_max"),
+			polars.Col("cpu").RollingStd(2).Alias("cpu_std"),
+		)
+		defer result.Free()
+
+		if result.Height() != df.Height() {
+			t.Errorf("Expected %d rows, got %d", df.Height(), result.Height())
+		}
+
+		if result.Width() != df.Width()+5 {
+			t.Errorf("Expected %d columns, got %d", df.Width()+5, result.Width())
+		}
+
+		assertValues(t, columnValues(t, result, "cpu_mean"), nil, 15.0, 25.0, 35.0, 45.0, 55.0)
+		assertValues(t, columnValues(t, result, "cpu_sum"), nil, nil, 60.0, 90.0, 120.0, 150.0)
+		assertValues(t, columnValues(t, result, "cpu_min"), nil, 10.0, 20.0, 30.0, 40.0, 50.0)
+		assertValues(t, columnValues(t, result, "cpu_max"), nil, 20.0, 30.0, 40.0, 50.0, 60.0)
+
+		// The first two windows are incomplete, so the sum is null there
+		filtered := result.Filter(polars.Col("cpu_sum").Gt(0))
+		defer filtered.Free()
+
+		if filtered.Height() != df.Height()-2 {
+			t.Errorf("Expected %d complete windows, got %d", df.Height()-2, filtered.Height())
+		}
+	})
+
+	t.Run("InvalidRollingWindow", func(t *testing.T) {
+		for name, expr := range map[string]polars.Expr{
+			"RollingSum":  polars.Col("cpu").RollingSum(0),
+			"RollingMean": polars.Col("cpu").RollingMean(-1),
+			"RollingMin":  polars.Col("cpu").RollingMin(0),
+			"RollingMax":  polars.Col("cpu").RollingMax(-2),
+			"RollingStd":  polars.Col("cpu").RollingStd(0),
+		} {
+			if result := df.Select(expr); result.Width() != 0 {
+				t.Errorf("Expected empty result for non-positive %s window", name)
+			}
+		}
+	})
+}
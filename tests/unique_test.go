@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/jordandelbar/go-polars/polars"
+)
+
+func TestUnique(t *testing.T) {
+	df, err := polars.NewDataFrame().
+		AddStringColumn("user", []string{"alice", "bob", "alice", "carol", "bob"}).
+		AddStringColumn("event", []string{"login", "login", "login", "logout", "click"}).
+		AddIntColumn("seq", []int64{1, 2, 3, 4, 5}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer df.Free()
+
+	dup, err := polars.NewDataFrame().
+		AddStringColumn("user", []string{"alice", "bob", "alice"}).
+		AddIntColumn("n", []int64{1, 2, 1}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to create DataFrame: %v", err)
+	}
+	defer dup.Free()
+
+	t.Run("AllColumns", func(t *testing.T) {
+		result := dup.Unique(nil, polars.UniqueKeepFirst, true)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "user"), "alice", "bob")
+	})
+
+	t.Run("KeepFirst", func(t *testing.T) {
+		result := df.Unique([]string{"user"}, polars.UniqueKeepFirst, true)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "seq"), int64(1), int64(2), int64(4))
+	})
+
+	t.Run("KeepLast", func(t *testing.T) {
+		result := df.Unique([]string{"user"}, polars.UniqueKeepLast, true)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "seq"), int64(3), int64(4), int64(5))
+	})
+
+	t.Run("KeepNone", func(t *testing.T) {
+		result := df.Unique([]string{"user"}, polars.UniqueKeepNone, true)
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "user"), "carol")
+	})
+
+	t.Run("KeepAny", func(t *testing.T) {
+		result := df.Unique([]string{"user", "event"}, polars.UniqueKeepAny, false)
+		defer result.Free()
+
+		if result.Height() != 4 {
+			t.Errorf("Expected 4 rows, got %d", result.Height())
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		if result := df.Unique([]string{"missing"}, polars.UniqueKeepFirst, true); result.Width() != 0 {
+			t.Error("Expected empty result for unknown subset column")
+		}
+		if result := df.Unique(nil, "sometimes", true); result.Width() != 0 {
+			t.Error("Expected empty result for unknown keep strategy")
+		}
+	})
+
+	t.Run("IsDuplicated", func(t *testing.T) {
+		mask := dup.IsDuplicated()
+		defer mask.Free()
+
+		if mask.Name() != "is_duplicated" || mask.Dtype() != polars.Boolean {
+			t.Fatalf("Expected Boolean is_duplicated Series, got %s %s", mask.Name(), mask.Dtype())
+		}
+		for i, want := range []bool{true, false, true} {
+			if got, _ := mask.Get(i); got != want {
+				t.Errorf("Expected %v at row %d, got %v", want, i, got)
+			}
+		}
+
+		n, err := dup.NUnique()
+		if err != nil {
+			t.Fatalf("Failed to count unique rows: %v", err)
+		}
+		if n != 2 {
+			t.Errorf("Expected 2 unique rows, got %d", n)
+		}
+	})
+
+	t.Run("UniqueExpr", func(t *testing.T) {
+		result := df.Select(polars.Col("user").Unique())
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "user"), "alice", "bob", "carol")
+	})
+
+	t.Run("IsUnique", func(t *testing.T) {
+		result := df.Filter(polars.Col("event").IsUnique())
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "event"), "logout", "click")
+	})
+
+	t.Run("IsFirstDistinct", func(t *testing.T) {
+		result := df.Filter(polars.Col("user").IsFirstDistinct())
+		defer result.Free()
+
+		assertValues(t, columnValues(t, result, "seq"), int64(1), int64(2), int64(4))
+	})
+}